@@ -184,3 +184,10 @@ func SetFileHash(file string, sum [HashSize]byte) {
 	hashFileCache.m[file] = sum
 	hashFileCache.Unlock()
 }
+
+// ForgetFileHash removes the hash of file cached by FileHash, e.g. after the file was changed.
+func ForgetFileHash(file string) {
+	hashFileCache.Lock()
+	delete(hashFileCache.m, file)
+	hashFileCache.Unlock()
+}
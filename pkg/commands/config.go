@@ -9,6 +9,8 @@ import (
 
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
 func (e *Executor) initConfig() {
@@ -31,6 +33,16 @@ func (e *Executor) initConfig() {
 	}
 	e.initRunConfiguration(pathCmd) // allow --config
 	cmd.AddCommand(pathCmd)
+
+	verifyCmd := &cobra.Command{
+		Use:               "verify",
+		Short:             "Verify the linter names and the linters settings referenced by the config",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		Run:               e.executeVerifyCmd,
+	}
+	e.initRunConfiguration(verifyCmd) // allow --config
+	cmd.AddCommand(verifyCmd)
 }
 
 // getUsedConfig returns the resolved path to the golangci config file, or the empty string
@@ -59,3 +71,26 @@ func (e *Executor) executePathCmd(_ *cobra.Command, _ []string) {
 
 	fmt.Println(usedConfigFile)
 }
+
+// executeVerifyCmd runs the 'config verify' CLI command, which reports the linter names of the config
+// (enable, disable, exclude rules, severity rules, etc.) which aren't known linters, nor aliases,
+// and the keys of linters-settings which aren't the settings of a linter.
+func (e *Executor) executeVerifyCmd(_ *cobra.Command, _ []string) {
+	refs := lintersdb.NewValidator(e.DBManager).FindUnknownLinterReferences(e.cfg)
+
+	var settingsKeys []string
+	for key := range viper.GetStringMap("linters-settings") {
+		settingsKeys = append(settingsKeys, key)
+	}
+	refs = append(refs, lintersdb.FindUnknownLintersSettings(settingsKeys)...)
+	if len(refs) == 0 {
+		return
+	}
+
+	for _, ref := range refs {
+		fmt.Fprintln(logutils.StdErr, ref)
+	}
+
+	fmt.Fprintln(logutils.StdErr, "Run 'golangci-lint help linters' to see the list of supported linters")
+	os.Exit(exitcodes.Failure)
+}
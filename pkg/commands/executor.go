@@ -27,6 +27,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
 	"github.com/golangci/golangci-lint/pkg/timeutils"
 )
 
@@ -34,25 +35,32 @@ type Executor struct {
 	rootCmd    *cobra.Command
 	runCmd     *cobra.Command
 	lintersCmd *cobra.Command
+	filesCmd   *cobra.Command
+	nolintCmd  *cobra.Command
 
 	exitCode              int
 	version, commit, date string
 
-	cfg               *config.Config // cfg is the unmarshaled data from the golangci config file.
-	log               logutils.Log
-	reportData        report.Data
-	DBManager         *lintersdb.Manager
-	EnabledLintersSet *lintersdb.EnabledSet
-	contextLoader     *lint.ContextLoader
-	goenv             *goutil.Env
-	fileCache         *fsutils.FileCache
-	lineCache         *fsutils.LineCache
-	pkgCache          *pkgcache.Cache
-	debugf            logutils.DebugFunc
-	sw                *timeutils.Stopwatch
+	cfg                *config.Config // cfg is the unmarshaled data from the golangci config file.
+	log                logutils.Log
+	reportData         report.Data
+	linterDurations    map[string]time.Duration // durations of the linters of the run, for the metrics
+	goAnalysisDuration time.Duration            // duration of the combined go/analysis linters of the run
+	DBManager          *lintersdb.Manager
+	EnabledLintersSet  *lintersdb.EnabledSet
+	contextLoader      *lint.ContextLoader
+	goenv              *goutil.Env
+	fileCache          *fsutils.FileCache
+	lineCache          *fsutils.LineCache
+	pkgCache           *pkgcache.Cache
+	debugf             logutils.DebugFunc
+	sw                 *timeutils.Stopwatch
 
 	loadGuard *load.Guard
 	flock     *flock.Flock
+
+	configTransform func(cfg *config.Config)
+	issueFilter     func(issue result.Issue) bool
 }
 
 // NewExecutor creates and initializes a new command executor.
@@ -97,6 +105,8 @@ func NewExecutor(version, commit, date string) *Executor {
 	e.initRun()
 	e.initHelp()
 	e.initLinters()
+	e.initFiles()
+	e.initNolint()
 	e.initConfig()
 	e.initVersion()
 	e.initCache()
@@ -120,6 +130,8 @@ func NewExecutor(version, commit, date string) *Executor {
 	// Slice options must be explicitly set for proper merging of config and command-line options.
 	fixSlicesFlags(e.runCmd.Flags())
 	fixSlicesFlags(e.lintersCmd.Flags())
+	fixSlicesFlags(e.filesCmd.Flags())
+	fixSlicesFlags(e.nolintCmd.Flags())
 
 	e.EnabledLintersSet = lintersdb.NewEnabledSet(e.DBManager,
 		lintersdb.NewValidator(e.DBManager), e.log.Child(logutils.DebugKeyLintersDB), e.cfg)
@@ -127,7 +139,7 @@ func NewExecutor(version, commit, date string) *Executor {
 	e.fileCache = fsutils.NewFileCache()
 	e.lineCache = fsutils.NewLineCache(e.fileCache)
 
-	e.sw = timeutils.NewStopwatch("pkgcache", e.log.Child(logutils.DebugKeyStopwatch))
+	e.sw = e.newPkgCacheStopwatch(commandLineCfg)
 	e.pkgCache, err = pkgcache.NewCache(e.sw, e.log.Child(logutils.DebugKeyPkgCache))
 	if err != nil {
 		e.log.Fatalf("Failed to build packages cache: %s", err)
@@ -135,24 +147,57 @@ func NewExecutor(version, commit, date string) *Executor {
 	e.loadGuard = load.NewGuard()
 	e.contextLoader = lint.NewContextLoader(e.cfg, e.log.Child(logutils.DebugKeyLoader), e.goenv,
 		e.lineCache, e.fileCache, e.pkgCache, e.loadGuard)
-	if err = e.initHashSalt(version); err != nil {
+	// the flags aren't parsed into e.cfg yet.
+	incremental := commandLineCfg != nil && commandLineCfg.Run.Incremental
+	if err = e.initHashSalt(version, incremental); err != nil {
 		e.log.Fatalf("Failed to init hash salt: %s", err)
 	}
 	e.debugf("Initialized executor in %s", time.Since(startedAt))
 	return e
 }
 
+// newPkgCacheStopwatch returns the stopwatch of the packages cache, without timings with --no-timing:
+// the flags aren't parsed into e.cfg yet.
+func (e *Executor) newPkgCacheStopwatch(commandLineCfg *config.Config) *timeutils.Stopwatch {
+	if e.cfg.Run.NoTiming || (commandLineCfg != nil && commandLineCfg.Run.NoTiming) {
+		return timeutils.NewStopwatch("pkgcache", logutils.NewDiscardLog())
+	}
+
+	return timeutils.NewStopwatch("pkgcache", e.log.Child(logutils.DebugKeyStopwatch))
+}
+
+// SetConfigTransform sets a function modifying the configuration programmatically,
+// e.g. to inject exclude rules computed at runtime: it's called once the configuration file
+// and the command-line flags are parsed, before the commands run.
+// The salt of the cache is computed again from the transformed configuration.
+// The transformed configuration is validated like the config file: an invalid one stops the command.
+// It's for the programs embedding golangci-lint: it must be set before Execute.
+// The configuration of the custom linters (linters-settings.custom) is loaded before it, and isn't affected.
+func (e *Executor) SetConfigTransform(transform func(cfg *config.Config)) {
+	e.configTransform = transform
+}
+
+// SetIssueFilter sets a predicate keeping only the issues of the run for which it returns true,
+// for the filters not expressible in the configuration (see lint.Runner.SetIssueFilter).
+// It's for the programs embedding golangci-lint: it must be set before Execute.
+func (e *Executor) SetIssueFilter(keep func(issue result.Issue) bool) {
+	e.issueFilter = keep
+}
+
 func (e *Executor) Execute() error {
 	return e.rootCmd.Execute()
 }
 
-func (e *Executor) initHashSalt(version string) error {
+// initHashSalt sets the salt of the cache.
+// In incremental mode, the linters settings aren't part of the salt: the cache keys of the results
+// of each linter have the hash of its settings, a change invalidates only the results of the linters concerned.
+func (e *Executor) initHashSalt(version string, incremental bool) error {
 	binSalt, err := computeBinarySalt(version)
 	if err != nil {
 		return errors.Wrap(err, "failed to calculate binary salt")
 	}
 
-	configSalt, err := computeConfigSalt(e.cfg)
+	configSalt, err := computeConfigSalt(e.cfg, !incremental)
 	if err != nil {
 		return errors.Wrap(err, "failed to calculate config salt")
 	}
@@ -189,18 +234,20 @@ func computeBinarySalt(version string) ([]byte, error) {
 	return h.Sum(nil), nil
 }
 
-func computeConfigSalt(cfg *config.Config) ([]byte, error) {
+func computeConfigSalt(cfg *config.Config, withLintersSettings bool) ([]byte, error) {
 	// We don't hash all config fields to reduce meaningless cache
 	// invalidations. At least, it has a huge impact on tests speed.
 
-	lintersSettingsBytes, err := yaml.Marshal(cfg.LintersSettings)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to json marshal config linter settings")
-	}
-
 	var configData bytes.Buffer
-	configData.WriteString("linters-settings=")
-	configData.Write(lintersSettingsBytes)
+	if withLintersSettings {
+		lintersSettingsBytes, err := yaml.Marshal(cfg.LintersSettings)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to json marshal config linter settings")
+		}
+
+		configData.WriteString("linters-settings=")
+		configData.Write(lintersSettingsBytes)
+	}
 	configData.WriteString("\nbuild-tags=%s" + strings.Join(cfg.Run.BuildTags, ","))
 
 	h := sha256.New()
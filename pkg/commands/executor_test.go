@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestNewPkgCacheStopwatchNoTiming(t *testing.T) {
+	log := logutils.NewMockLog()
+	log.On("Child", logutils.DebugKeyStopwatch)
+
+	commandLineCfg := config.NewDefault()
+	commandLineCfg.Run.NoTiming = true
+
+	// the mock panics on the unexpected timings of the stages.
+	e := &Executor{cfg: config.NewDefault(), log: log}
+	sw := e.newPkgCacheStopwatch(commandLineCfg)
+	sw.TrackStage("gob", func() {})
+	sw.Print()
+
+	log.AssertNotCalled(t, "Child", logutils.DebugKeyStopwatch)
+}
+
+func TestNewPkgCacheStopwatch(t *testing.T) {
+	log := logutils.NewMockLog()
+	log.On("Child", logutils.DebugKeyStopwatch)
+
+	e := &Executor{cfg: config.NewDefault(), log: log}
+	e.newPkgCacheStopwatch(nil)
+
+	log.AssertCalled(t, "Child", logutils.DebugKeyStopwatch)
+}
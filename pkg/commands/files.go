@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func (e *Executor) initFiles() {
+	e.filesCmd = &cobra.Command{
+		Use:   "files",
+		Short: "List the files a linter analyzes",
+		RunE:  e.executeFiles,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if ok := e.acquireFileLock(); !ok {
+				return errors.New("parallel golangci-lint is running")
+			}
+			return nil
+		},
+		PostRun: func(_ *cobra.Command, _ []string) {
+			e.releaseFileLock()
+		},
+	}
+	e.rootCmd.AddCommand(e.filesCmd)
+
+	e.filesCmd.SetOut(logutils.StdOut) // use custom output to properly color it in Windows terminals
+	e.filesCmd.SetErr(logutils.StdErr)
+
+	e.initRunConfiguration(e.filesCmd)
+	initFilesFlagSet(e.filesCmd.Flags(), e.cfg)
+}
+
+func initFilesFlagSet(fs *pflag.FlagSet, cfg *config.Config) {
+	// Files config
+	fc := &cfg.Files
+	fs.StringVar(&fc.Linter, "linter", "", wh("Name of the linter to list the files of"))
+}
+
+// executeFiles runs the 'files' CLI command, which prints the files the linter analyzes,
+// one path per line, sorted.
+func (e *Executor) executeFiles(_ *cobra.Command, args []string) error {
+	if e.cfg.Files.Linter == "" {
+		return errors.New("the --linter option is required")
+	}
+
+	lcs := e.DBManager.GetLinterConfigs(e.cfg.Files.Linter)
+	if len(lcs) == 0 {
+		return fmt.Errorf("unknown linter %q", e.cfg.Files.Linter)
+	}
+
+	e.setTimeoutToDeadlineIfOnlyDeadlineIsSet()
+	ctx, cancel := context.WithTimeout(context.Background(), e.cfg.Run.Timeout)
+	defer cancel()
+
+	if err := e.goenv.Discover(ctx); err != nil {
+		e.log.Warnf("Failed to discover go env: %s", err)
+	}
+
+	e.cfg.Run.Args = args
+
+	lintCtx, err := e.contextLoader.Load(ctx, lcs)
+	if err != nil {
+		return errors.Wrap(err, "context loading failed")
+	}
+
+	runner, err := lint.NewRunner(e.cfg, e.log.Child(logutils.DebugKeyRunner),
+		e.goenv, e.EnabledLintersSet, e.lineCache, e.DBManager, lintCtx.Packages, nil)
+	if err != nil {
+		return err
+	}
+
+	// a name can be an alias of several linters.
+	filesSet := map[string]bool{}
+	for _, lc := range lcs {
+		files, err := runner.LinterFiles(lc.Name(), lintCtx.Packages)
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			filesSet[file] = true
+		}
+	}
+
+	files := make([]string, 0, len(filesSet))
+	for file := range filesSet {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		fmt.Fprintln(logutils.StdOut, file)
+	}
+
+	return nil
+}
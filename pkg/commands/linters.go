@@ -1,12 +1,17 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
+	"runtime/debug"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
+	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
 func (e *Executor) initLinters() {
@@ -19,6 +24,14 @@ func (e *Executor) initLinters() {
 	}
 	e.rootCmd.AddCommand(e.lintersCmd)
 	e.initRunConfiguration(e.lintersCmd)
+	initLintersCmdFlagSet(e.lintersCmd.Flags(), e.cfg)
+}
+
+func initLintersCmdFlagSet(fs *pflag.FlagSet, cfg *config.Config) {
+	// Linters command config
+	lc := &cfg.LintersCmd
+	fs.BoolVar(&lc.JSON, "json", false,
+		wh("Print the manifest of all the linters, with their enabled state, settings and source module version, as JSON"))
 }
 
 // executeLinters runs the 'linters' CLI command, which displays the supported linters.
@@ -28,6 +41,14 @@ func (e *Executor) executeLinters(_ *cobra.Command, _ []string) error {
 		return fmt.Errorf("can't get enabled linters: %w", err)
 	}
 
+	if e.cfg.LintersCmd.JSON {
+		info, _ := debug.ReadBuildInfo()
+
+		encoder := json.NewEncoder(logutils.StdOut)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(e.DBManager.GetManifest(e.version, enabledLintersMap, info))
+	}
+
 	color.Green("Enabled by your configuration linters:\n")
 	enabledLinters := make([]*linter.Config, 0, len(enabledLintersMap))
 	for _, linter := range enabledLintersMap {
@@ -0,0 +1,180 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/golinters"
+	"github.com/golangci/golangci-lint/pkg/lint"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+)
+
+func (e *Executor) initNolint() {
+	e.nolintCmd = &cobra.Command{
+		Use:   "nolint",
+		Short: "Manage nolint directives",
+		RunE:  e.executeNolint,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if ok := e.acquireFileLock(); !ok {
+				return errors.New("parallel golangci-lint is running")
+			}
+			return nil
+		},
+		PostRun: func(_ *cobra.Command, _ []string) {
+			e.releaseFileLock()
+		},
+	}
+	e.rootCmd.AddCommand(e.nolintCmd)
+
+	e.nolintCmd.SetOut(logutils.StdOut) // use custom output to properly color it in Windows terminals
+	e.nolintCmd.SetErr(logutils.StdErr)
+
+	e.initRunConfiguration(e.nolintCmd)
+	initNolintFlagSet(e.nolintCmd.Flags(), e.cfg)
+}
+
+func initNolintFlagSet(fs *pflag.FlagSet, cfg *config.Config) {
+	// Nolint config
+	nc := &cfg.Nolint
+	fs.BoolVar(&nc.Prune, "prune", false, wh("Remove the unused nolint directives: dry-run unless --write is set"))
+	fs.BoolVar(&nc.Write, "write", false, wh("Rewrite the source files instead of only printing the changes"))
+}
+
+// executeNolint runs the 'nolint' CLI command, which prints (or applies with --write)
+// the changes removing the nolint directives which suppress no issues.
+func (e *Executor) executeNolint(_ *cobra.Command, args []string) error {
+	if !e.cfg.Nolint.Prune {
+		return errors.New("the --prune option is required")
+	}
+
+	// unused directives are reported by nolintlint, whatever its configuration is.
+	e.cfg.Linters.Enable = append(e.cfg.Linters.Enable, golinters.NoLintLintName)
+	e.cfg.Linters.Disable = removeString(e.cfg.Linters.Disable, golinters.NoLintLintName)
+	e.cfg.LintersSettings.NoLintLint.AllowUnused = false
+
+	e.setTimeoutToDeadlineIfOnlyDeadlineIsSet()
+	ctx, cancel := context.WithTimeout(context.Background(), e.cfg.Run.Timeout)
+	defer cancel()
+
+	if err := e.goenv.Discover(ctx); err != nil {
+		e.log.Warnf("Failed to discover go env: %s", err)
+	}
+
+	directives, err := e.getUnusedNolintDirectives(ctx, args)
+	if err != nil {
+		return err
+	}
+
+	editsByFile := processors.GetNolintPruneEdits(directives)
+
+	files := make([]string, 0, len(editsByFile))
+	for file := range editsByFile {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	var failedFiles int
+	for _, file := range files {
+		edits := editsByFile[file]
+		for _, edit := range edits {
+			fmt.Fprintln(logutils.StdOut, edit)
+		}
+
+		if !e.cfg.Nolint.Write {
+			continue
+		}
+
+		// a file is either fully pruned or left untouched.
+		if err := writeNolintEdits(file, edits); err != nil {
+			e.log.Errorf("Failed to prune nolint directives of %s: %s", file, err)
+			failedFiles++
+		}
+	}
+
+	if failedFiles != 0 {
+		return fmt.Errorf("failed to prune nolint directives of %d files", failedFiles)
+	}
+
+	return nil
+}
+
+func (e *Executor) getUnusedNolintDirectives(ctx context.Context, args []string) ([]processors.UnusedNolintDirective, error) {
+	if !logutils.HaveDebugTag(logutils.DebugKeyLintersOutput) {
+		// Don't allow linters and loader to print anything
+		log.SetOutput(io.Discard)
+		savedStdout, savedStderr := e.setOutputToDevNull()
+		defer func() {
+			os.Stdout, os.Stderr = savedStdout, savedStderr
+		}()
+	}
+
+	e.cfg.Run.Args = args
+
+	lintersToRun, err := e.EnabledLintersSet.GetOptimizedLinters()
+	if err != nil {
+		return nil, err
+	}
+
+	lintCtx, err := e.contextLoader.Load(ctx, lintersToRun)
+	if err != nil {
+		return nil, errors.Wrap(err, "context loading failed")
+	}
+	lintCtx.Log = e.log.Child(logutils.DebugKeyLintersContext)
+
+	runner, err := lint.NewRunner(e.cfg, e.log.Child(logutils.DebugKeyRunner),
+		e.goenv, e.EnabledLintersSet, e.lineCache, e.DBManager, lintCtx.Packages, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = runner.Run(ctx, lintersToRun, lintCtx); err != nil {
+		return nil, err
+	}
+
+	for _, p := range runner.Processors {
+		if nolint, ok := p.(*processors.Nolint); ok {
+			return nolint.UnusedDirectives(), nil
+		}
+	}
+
+	return nil, errors.New("no nolint processor")
+}
+
+func writeNolintEdits(file string, edits []processors.NolintEdit) error {
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	newContent, err := processors.ApplyNolintEdits(content, edits)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(file, newContent, info.Mode())
+}
+
+func removeString(values []string, value string) []string {
+	var res []string
+	for _, v := range values {
+		if v != value {
+			res = append(res, v)
+		}
+	}
+	return res
+}
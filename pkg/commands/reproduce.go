@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// reproduceConfigFile is the name of the config file of the printed reproduction command.
+const reproduceConfigFile = ".golangci-reproduce.yml"
+
+// reproduceSkippedFlags are the flags of the run which aren't in the reproduction command:
+// the config of the command is the printed one.
+var reproduceSkippedFlags = map[string]bool{
+	"config":          true,
+	"no-config":       true,
+	"print-reproduce": true,
+}
+
+var shellSafeRe = regexp.MustCompile(`^[A-Za-z0-9_./:=,@%+-]+$`)
+
+// printReproduce prints, without running the linters, a description of the run to attach to a bug report:
+// the versions, the enabled linters, the key settings, the command and the merged config.
+// The output is a YAML config: saved as reproduceConfigFile, it's the config of the printed command.
+func (e *Executor) printReproduce(w io.Writer, cmd *cobra.Command, args []string) error {
+	enabledLintersMap, err := e.EnabledLintersSet.GetEnabledLintersMap()
+	if err != nil {
+		return fmt.Errorf("can't get enabled linters: %w", err)
+	}
+
+	enabledLinters := make([]string, 0, len(enabledLintersMap))
+	for name := range enabledLintersMap {
+		enabledLinters = append(enabledLinters, name)
+	}
+	sort.Strings(enabledLinters)
+
+	usedConfigFile := e.getUsedConfig()
+	if usedConfigFile == "" {
+		usedConfigFile = "none"
+	}
+
+	buildTags := strings.Join(e.cfg.Run.BuildTags, ",")
+	if buildTags == "" {
+		buildTags = "none"
+	}
+
+	fmt.Fprintf(w, "# golangci-lint has version %s built from %s on %s\n", e.version, e.commit, e.date)
+	fmt.Fprintf(w, "# Built with: %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(w, "# Go version of the analysis: %s\n", e.cfg.Run.Go)
+	fmt.Fprintf(w, "# Build tags: %s\n", buildTags)
+	fmt.Fprintf(w, "# Tests: %t, modules download mode: %q, timeout: %s\n",
+		e.cfg.Run.AnalyzeTests, e.cfg.Run.ModulesDownloadMode, e.cfg.Run.Timeout)
+	fmt.Fprintf(w, "# Config file: %s\n", usedConfigFile)
+	fmt.Fprintf(w, "# Enabled linters (%d): %s\n", len(enabledLinters), strings.Join(enabledLinters, ", "))
+	fmt.Fprintf(w, "#\n# Save this file as %s and run:\n#   %s\n\n", reproduceConfigFile, reproduceCommand(cmd.Flags(), args))
+
+	settings := viper.AllSettings()
+	if len(settings) == 0 {
+		fmt.Fprintln(w, "{}")
+		return nil
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(settings); err != nil {
+		return fmt.Errorf("failed to marshal the config: %w", err)
+	}
+
+	return encoder.Close()
+}
+
+// reproduceCommand returns the command line of the run with the flags set on the command line or by the config,
+// and the config printed by printReproduce.
+func reproduceCommand(fs *pflag.FlagSet, args []string) string {
+	parts := []string{"golangci-lint", "run", "--config=" + reproduceConfigFile}
+
+	fs.Visit(func(f *pflag.Flag) {
+		if reproduceSkippedFlags[f.Name] {
+			return
+		}
+
+		value := f.Value.String()
+		if f.Value.Type() == "stringSlice" {
+			s, err := fs.GetStringSlice(f.Name)
+			if err == nil {
+				value = strings.Join(s, ",")
+			}
+		}
+
+		parts = append(parts, fmt.Sprintf("--%s=%s", f.Name, shellQuote(value)))
+	})
+
+	for _, arg := range args {
+		parts = append(parts, shellQuote(arg))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// shellQuote quotes the value for a POSIX shell, if needed.
+func shellQuote(value string) string {
+	if shellSafeRe.MatchString(value) {
+		return value
+	}
+
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellQuote(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected string
+	}{
+		{desc: "safe", value: "./pkg/...", expected: "./pkg/..."},
+		{desc: "safe punctuation", value: "a=b,c:d@e%f+g-h", expected: "a=b,c:d@e%f+g-h"},
+		{desc: "empty", value: "", expected: "''"},
+		{desc: "space", value: "a b", expected: "'a b'"},
+		{desc: "shell characters", value: "$HOME;*", expected: "'$HOME;*'"},
+		{desc: "single quote", value: "it's", expected: `'it'\''s'`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, shellQuote(tc.value))
+		})
+	}
+}
+
+func TestReproduceCommand(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		args     []string
+		expected string
+	}{
+		{
+			desc:     "no flags",
+			expected: "golangci-lint run --config=.golangci-reproduce.yml",
+		},
+		{
+			desc:     "flags and args",
+			args:     []string{"--timeout=5m", "--build-tags=a b", "./pkg/...", "my dir"},
+			expected: "golangci-lint run --config=.golangci-reproduce.yml --build-tags='a b' --timeout=5m ./pkg/... 'my dir'",
+		},
+		{
+			desc:     "slice flag",
+			args:     []string{"--enable=gofmt", "-E", "govet,errcheck"},
+			expected: "golangci-lint run --config=.golangci-reproduce.yml --enable=gofmt,govet,errcheck",
+		},
+		{
+			desc:     "excluded flags",
+			args:     []string{"--config=my.yml", "--print-reproduce", "--timeout=5m"},
+			expected: "golangci-lint run --config=.golangci-reproduce.yml --timeout=5m",
+		},
+		{
+			desc:     "no config",
+			args:     []string{"--no-config"},
+			expected: "golangci-lint run --config=.golangci-reproduce.yml",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			fs := pflag.NewFlagSet("run", pflag.ContinueOnError)
+			fs.String("config", "", "")
+			fs.Bool("no-config", false, "")
+			fs.Bool("print-reproduce", false, "")
+			fs.String("timeout", "1m", "")
+			fs.String("build-tags", "", "")
+			fs.StringSliceP("enable", "E", nil, "")
+
+			require.NoError(t, fs.Parse(tc.args))
+			assert.Equal(t, tc.expected, reproduceCommand(fs, fs.Args()))
+		})
+	}
+}
@@ -23,6 +23,20 @@ const (
 )
 
 func (e *Executor) persistentPreRun(_ *cobra.Command, _ []string) error {
+	if e.configTransform != nil {
+		// the flags are parsed: the configuration is final.
+		e.configTransform(e.cfg)
+
+		if err := e.cfg.Validate(); err != nil {
+			return fmt.Errorf("can't validate the transformed config: %w", err)
+		}
+
+		// the salt of the cache was computed from the configuration before the transform.
+		if err := e.initHashSalt(e.version, e.cfg.Run.Incremental); err != nil {
+			return fmt.Errorf("failed to init hash salt: %w", err)
+		}
+	}
+
 	if e.cfg.Run.PrintVersion {
 		_, _ = fmt.Fprintf(logutils.StdOut, "golangci-lint has version %s built from %s on %s\n", e.version, e.commit, e.date)
 		os.Exit(exitcodes.Success) // a return nil is not enough to stop the process because we are inside the `preRun`.
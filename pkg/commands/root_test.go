@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/internal/cache"
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+func TestConfigTransformValidated(t *testing.T) {
+	e := &Executor{cfg: config.NewDefault()}
+
+	e.SetConfigTransform(func(cfg *config.Config) {
+		cfg.Issues.MaxTotal = 10
+	})
+	require.NoError(t, e.persistentPreRun(nil, nil))
+	assert.Equal(t, 10, e.cfg.Issues.MaxTotal)
+
+	e.SetConfigTransform(func(cfg *config.Config) {
+		cfg.Issues.MaxTotal = -1
+	})
+	assert.EqualError(t, e.persistentPreRun(nil, nil),
+		"can't validate the transformed config: issues.max-total must be positive or 0, got -1")
+}
+
+func TestConfigTransformHashSalt(t *testing.T) {
+	hash := func() [cache.HashSize]byte {
+		h, err := cache.NewHash("test")
+		require.NoError(t, err)
+		return h.Sum()
+	}
+
+	e := &Executor{cfg: config.NewDefault(), version: "v1.0.0"}
+	require.NoError(t, e.initHashSalt(e.version, false))
+	t.Cleanup(func() { cache.SetSalt(nil) })
+	before := hash()
+
+	// the linters settings are part of the salt: the results of the cache are the ones of the transformed settings.
+	e.SetConfigTransform(func(cfg *config.Config) {
+		cfg.LintersSettings.Lll.LineLength = 80
+	})
+	require.NoError(t, e.persistentPreRun(nil, nil))
+	assert.NotEqual(t, before, hash())
+}
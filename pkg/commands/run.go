@@ -1,11 +1,14 @@
 package commands
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
@@ -14,14 +17,19 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	gopackages "golang.org/x/tools/go/packages"
 
+	"github.com/golangci/golangci-lint/internal/cache"
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/lint"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/packages"
 	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
 	"github.com/golangci/golangci-lint/pkg/result/processors"
 )
@@ -49,8 +57,8 @@ func getDefaultIssueExcludeHelp() string {
 
 func getDefaultDirectoryExcludeHelp() string {
 	parts := []string{"Use or not use default excluded directories:"}
-	for _, dir := range packages.StdExcludeDirRegexps {
-		parts = append(parts, fmt.Sprintf("  - %s", color.YellowString(dir)))
+	for _, dir := range packages.StdExcludeDirs {
+		parts = append(parts, fmt.Sprintf("  - %s: %s", dir.Name, color.YellowString(dir.Regexp)))
 	}
 	parts = append(parts, "")
 	return strings.Join(parts, "\n")
@@ -85,10 +93,38 @@ func initFlagSet(fs *pflag.FlagSet, cfg *config.Config, m *lintersdb.Manager, is
 		wh(fmt.Sprintf("Format of output: %s", strings.Join(config.OutFormats, "|"))))
 	fs.BoolVar(&oc.PrintIssuedLine, "print-issued-lines", true, wh("Print lines of code with issue"))
 	fs.BoolVar(&oc.PrintLinterName, "print-linter-name", true, wh("Print linter name in issue line"))
+	fs.BoolVar(&oc.ShowPackage, "show-package", false,
+		wh("Print the package import path of the issues in the line-number and tab formats"))
+	fs.BoolVar(&oc.ShowIndex, "show-index", false,
+		wh("Print the 1-based index of the issues in the output in the line-number formats"))
+	fs.IntVar(&oc.SourceTabWidth, "source-tab-width", 0,
+		wh("Expand the tabs of the printed lines of code to this width, and adjust the columns (0: no expansion)"))
+	fs.BoolVar(&oc.IncludeOffsets, "include-offsets", false,
+		wh("Compute the byte offsets in the files of the issues positions, emitted in the JSON format"))
+	fs.BoolVar(&oc.IncludeEnclosingFunc, "include-enclosing-func", false,
+		wh("Set the name of the function enclosing each issue, emitted in the JSON format"))
 	fs.BoolVar(&oc.UniqByLine, "uniq-by-line", true, wh("Make issues output unique by line"))
 	fs.BoolVar(&oc.SortResults, "sort-results", false, wh("Sort linter results"))
+	fs.StringVar(&oc.MetricsFile, "metrics-file", "",
+		wh("Write the metrics of the run (issues counts and durations) to this file, in the Prometheus text format"))
+	fs.StringSliceVar(&oc.LowPriorityPaths, "low-priority-paths", nil,
+		wh("Globs of the paths whose issues are sorted after the other issues (e.g. legacy/**)"))
+	fs.BoolVar(&oc.SplitCheckIDs, "split-check-ids", false,
+		wh("Move the check IDs prefixing the messages, e.g. \"SA1019: ...\", to the check IDs of the issues"))
+	fs.BoolVar(&oc.ReportLinterStatuses, "report-linter-statuses", false,
+		wh("Add the linters which ran, by outcome (issues, clean, errored), to the JSON report"))
 	fs.BoolVar(&oc.PrintWelcomeMessage, "print-welcome", false, wh("Print welcome message"))
 	fs.StringVar(&oc.PathPrefix, "path-prefix", "", wh("Path prefix to add to output"))
+	fs.StringVar(&oc.PathBase, "path-base", "",
+		wh("Directory the output paths are relative to, instead of the current directory"))
+	fs.StringVar(&oc.Compress, "out-compress", "",
+		wh(fmt.Sprintf("Compression of the outputs written to files: %s (default for the paths ending with .gz)", config.OutCompressGzip)))
+	fs.StringVar(&oc.FingerprintMode, "fingerprint-mode", config.FingerprintModePosition,
+		wh(fmt.Sprintf("Algorithm of the issues fingerprints: %s|%s",
+			config.FingerprintModePosition, config.FingerprintModeContent)))
+	fs.StringVar(&oc.JunitXMLGroupBy, "junit-xml-group-by", config.JunitXMLGroupByFile,
+		wh(fmt.Sprintf("Test suites of the junit-xml output format: one per file (%s) or one per linter (%s)",
+			config.JunitXMLGroupByFile, config.JunitXMLGroupByLinter)))
 	hideFlag("print-welcome") // no longer used
 
 	fs.BoolVar(&cfg.InternalCmdTest, "internal-cmd-test", false, wh("Option is used only for testing golangci-lint command, don't use it"))
@@ -100,10 +136,17 @@ func initFlagSet(fs *pflag.FlagSet, cfg *config.Config, m *lintersdb.Manager, is
 	rc := &cfg.Run
 	fs.StringVar(&rc.ModulesDownloadMode, "modules-download-mode", "",
 		"Modules download mode. If not empty, passed as -mod=<mode> to go tools")
+	fs.StringVar(&rc.LoadMode, "load-mode", lint.LoadModeFull,
+		wh(fmt.Sprintf("What to load of the packages: %s (the files, the types and the dependencies), "+
+			"%s (what the enabled linters need) or %s (only the files)", lint.LoadModeFull, lint.LoadModeAuto, lint.LoadModeSyntax)))
+	fs.IntVar(&rc.AnalyzerConcurrency, "analyzer-concurrency", 0,
+		wh("Maximum number of packages analyzed in parallel by the go/analysis linters (default GOMAXPROCS)"))
 	fs.IntVar(&rc.ExitCodeIfIssuesFound, "issues-exit-code",
 		exitcodes.IssuesFound, wh("Exit code when issues were found"))
 	fs.StringVar(&rc.Go, "go", "", wh("Targeted Go version"))
 	fs.StringSliceVar(&rc.BuildTags, "build-tags", nil, wh("Build tags"))
+	fs.StringSliceVar(&rc.GoVersions, "go-versions", nil,
+		wh("Go versions to load the packages for, once per version, to lint the files gated by these versions"))
 
 	fs.DurationVar(&rc.Timeout, "deadline", defaultTimeout, wh("Deadline for total work"))
 	if err := fs.MarkHidden("deadline"); err != nil {
@@ -114,10 +157,33 @@ func initFlagSet(fs *pflag.FlagSet, cfg *config.Config, m *lintersdb.Manager, is
 	fs.BoolVar(&rc.AnalyzeTests, "tests", true, wh("Analyze tests (*_test.go)"))
 	fs.BoolVar(&rc.PrintResourcesUsage, "print-resources-usage", false,
 		wh("Print avg and max memory usage of golangci-lint and total time"))
-	fs.StringVarP(&rc.Config, "config", "c", "", wh("Read config from file path `PATH`"))
+	fs.BoolVar(&rc.Bench, "bench", false, wh("Print the wall time and the memory delta of each linter run, e.g. with --only"))
+	fs.BoolVar(&rc.NoTiming, "no-timing", false, wh("Don't print the timings of the stages, even in verbose mode"))
+	fs.IntVar(&rc.ProfileFiles, "profile-files", 0,
+		wh("Print the N slowest files to analyze by the go/analysis linters, estimated from the times of their packages"))
+	fs.BoolVar(&rc.PrintReproduce, "print-reproduce", false,
+		wh("Print the versions, the enabled linters, the command and the merged config to reproduce the run "+
+			"(e.g. for a bug report), without running the linters"))
+	fs.BoolVar(&rc.Incremental, "incremental", false,
+		wh("Cache the results of each linter for its own settings: after a change of the settings, "+
+			"only the linters whose settings changed run again, the others reuse their cached results"))
+	fs.StringVar(&rc.ShuffleLinters, "shuffle-linters", "",
+		wh(fmt.Sprintf("Run the linters in a random order to detect order-dependent bugs: --shuffle-linters (or =%s) "+
+			"for a random seed, --shuffle-linters=SEED to reproduce an order", lint.ShuffleLintersRandom)))
+	fs.Lookup("shuffle-linters").NoOptDefVal = lint.ShuffleLintersRandom
+	fs.StringVarP(&rc.Config, "config", "c", "",
+		wh("Read config from file path or HTTP(S) URL `PATH` (with $GOLANGCI_LINT_CONFIG_TOKEN as bearer token over HTTPS if set)"))
 	fs.BoolVar(&rc.NoConfig, "no-config", false, wh("Don't read config"))
 	fs.StringSliceVar(&rc.SkipDirs, "skip-dirs", nil, wh("Regexps of directories to skip"))
 	fs.BoolVar(&rc.UseDefaultSkipDirs, "skip-dirs-use-default", true, getDefaultDirectoryExcludeHelp())
+	fs.StringSliceVar(&rc.DisableDefaultSkipDirs, "disable-default-skip-dirs", nil,
+		wh("Names of the default excluded directories to not skip, e.g. testdata"))
+	fs.BoolVar(&rc.SkipVendor, "skip-vendor", true,
+		wh("Skip the issues of the vendored code: the vendor directories at the root of a module"))
+	fs.BoolVar(&rc.OnlyTrackedFiles, "only-tracked-files", false,
+		wh("Report only the issues of the files tracked by git (git ls-files)"))
+	fs.StringSliceVar(&rc.GoFileExtensions, "go-file-extensions", nil,
+		wh("Extensions of the files considered as Go source files besides .go by the skip logic, e.g. .go.tmpl"))
 	fs.StringSliceVar(&rc.SkipFiles, "skip-files", nil, wh("Regexps of files to skip"))
 
 	const allowParallelDesc = "Allow multiple parallel golangci-lint instances running. " +
@@ -216,18 +282,93 @@ func initFlagSet(fs *pflag.FlagSet, cfg *config.Config, m *lintersdb.Manager, is
 		wh(fmt.Sprintf("Enable presets (%s) of linters. Run 'golangci-lint linters' to see "+
 			"them. This option implies option --disable-all", strings.Join(m.AllPresets(), "|"))))
 	fs.BoolVar(&lc.Fast, "fast", false, wh("Run only fast linters from enabled linters set (first run won't be fast)"))
+	fs.StringVar(&lc.Only, "only", "", wh("Run only this linter, ignoring the other options enabling or disabling linters"))
 
 	// Issues config
 	ic := &cfg.Issues
 	fs.StringSliceVarP(&ic.ExcludePatterns, "exclude", "e", nil, wh("Exclude issue by regexp"))
 	fs.BoolVar(&ic.UseDefaultExcludes, "exclude-use-default", true, getDefaultIssueExcludeHelp())
+	fs.BoolVar(&ic.UseLinterRecommendedExcludes, "use-linter-recommended-excludes", false,
+		wh("Exclude the issues the linters recommend excluding, e.g. the stylecheck checks disabled by default by staticcheck"))
+	fs.BoolVar(&ic.ExcludeNoopFixes, "exclude-noop-fixes", true,
+		wh("Exclude the issues whose suggested fix is identical to the source it replaces"))
+	fs.BoolVar(&ic.SidecarAllowlist, "sidecar-allowlist", false,
+		wh("Exclude the issues of a file matching the patterns of its allowlist file, e.g. foo.go.lintallow for foo.go"))
+	fs.BoolVar(&ic.SidecarAllowlistReportUnused, "sidecar-allowlist-report-unused", false,
+		wh("Warn about the patterns of the allowlist files matching no issue"))
+	fs.StringSliceVar(&ic.GeneratedFilesLinters, "generated-files-linters", nil,
+		wh("Linters whose issues are reported in the generated files too, e.g. goheader (the others are skipped)"))
+	fs.StringVar(&ic.InlineGeneratedMarker, "inline-generated-marker", "",
+		wh("Exclude the issues on the lines containing this marker, e.g. '// generated-dont-lint'"))
+	fs.StringVar(&cfg.Severity.CoverageProfile, "coverage-profile", "",
+		wh("Raise by one level (info, warning, error) the severity of the issues on the lines not covered "+
+			"by the tests according to this Go coverage profile"))
+	fs.BoolVar(&ic.WarnUnusedExcludeRules, "warn-unused-exclude-rules", false,
+		wh("Warn about the exclude rules of the configuration matching no issue"))
+	fs.StringSliceVar(&ic.MergeRangeLinters, "merge-range-linters", nil,
+		wh("Linters reporting the start and the end of a range as two issues: each pair is merged into one ranged issue"))
+	fs.BoolVar(&ic.RollupConsecutive, "rollup-consecutive", false,
+		wh("Collapse the issues of a linter with the same text on consecutive lines into one issue ranging over the lines"))
 	fs.BoolVar(&ic.ExcludeCaseSensitive, "exclude-case-sensitive", false, wh("If set to true exclude "+
 		"and exclude rules regular expressions are case sensitive"))
+	fs.StringVar(&ic.InvalidPositions, "invalid-positions", processors.InvalidPositionsDrop,
+		wh("What to do with the issues whose position is outside the file: drop or clamp"))
+	fs.StringVar(&ic.KnownCompilerDiagnostics, "known-compiler-diagnostics", "",
+		wh("Don't report the typecheck issues found in this output of the compiler (e.g. go build), - for stdin"))
+	fs.BoolVar(&ic.FollowLineDirectives, "follow-line-directives", false,
+		wh("Report the issues of the files with //line directives at the original file and line (e.g. the template)"))
+	fs.StringVar(&ic.SkipFilesLargerThan, "skip-files-larger-than", "",
+		wh("Don't report issues of files larger than this size, in bytes (e.g. 500000, 500KB, 1MB) or lines (e.g. 2000 lines)"))
 
 	fs.IntVar(&ic.MaxIssuesPerLinter, "max-issues-per-linter", 50,
 		wh("Maximum issues count per one linter. Set to 0 to disable"))
 	fs.IntVar(&ic.MaxSameIssues, "max-same-issues", 3,
 		wh("Maximum count of issues with the same text. Set to 0 to disable"))
+	fs.IntVar(&ic.MaxDistinctLinters, "max-distinct-linters", 0,
+		wh("Maximum count of distinct linters reporting issues: only the linters with the most issues are kept. "+
+			"Set to 0 to disable"))
+	fs.IntVar(&ic.MaxFiles, "max-files", 0,
+		wh("Maximum count of distinct files with issues: only the files with the most issues are kept. Set to 0 to disable"))
+	fs.IntVar(&ic.PackageBudget, "package-budget", 0,
+		wh("Maximum weighted sum of the issues per package: the lowest weighted excess issues are dropped. "+
+			"Set to 0 to disable"))
+	fs.StringToIntVar(&ic.LinterWeights, "linter-weights", nil,
+		wh("Weights of the issues of the linters for package-budget, e.g. gosec=5,lll=1 (default weight is 1)"))
+	fs.IntVar(&ic.MinColumn, "min-column", 0,
+		wh("Drop the issues reported before this column (the issues with an unknown column are kept). Set to 0 to disable"))
+	fs.StringToIntVar(&ic.MinColumnPerLinter, "min-column-per-linter", nil,
+		wh("Minimum columns of the issues of the linters, overriding min-column, e.g. gofmt=5,lll=0 (0 disables it)"))
+	fs.StringSliceVar(&ic.AllowedDeprecatedSymbols, "allowed-deprecated-symbols", nil,
+		wh("Deprecated symbols whose staticcheck SA1019 issues are dropped, e.g. ioutil.ReadFile or io/ioutil"))
+	fs.IntVar(&ic.MaxTotal, "max-total", 0,
+		wh("Maximum count of reported issues: only the first ones (in the output order) are reported. Set to 0 to disable"))
+	fs.BoolVar(&ic.ConfidenceRanking, "confidence-ranking", false,
+		wh("Sort the issues by descending confidence score: linter weight (see linter-weights) x proximity to the changes x severity"))
+	fs.IntVar(&ic.ConfidenceTop, "confidence-top", 0,
+		wh("Keep only this count of the issues with the highest confidence scores (requires confidence-ranking). Set to 0 to keep all"))
+	fs.IntVar(&ic.ReportThreshold, "report-threshold", 0,
+		wh("Report the issues only if there are more than this count, none otherwise. Set to 0 to always report"))
+	fs.BoolVar(&ic.DedupSymlinks, "dedup-symlinks", true,
+		wh("Drop duplicated issues reported for the same file reached by different paths through symlinks"))
+	fs.StringVar(&ic.NormalizePathCase, "normalize-path-case", processors.NormalizePathCaseAuto,
+		wh(fmt.Sprintf("Replace the paths of the issues by the paths with the case of the files on disk: %s "+
+			"(on case-insensitive filesystems), %s or %s", processors.NormalizePathCaseAuto,
+			processors.NormalizePathCaseAlways, processors.NormalizePathCaseNever)))
+	fs.BoolVar(&ic.DedupTestVariants, "dedup-test-variants", true,
+		wh("Drop duplicated issues reported for both the normal and the test variant of a package"))
+	fs.BoolVar(&ic.UniquePositions, "unique-positions", false,
+		wh("Keep at most one issue per file, line and column: the one with the highest severity, then linter weight"))
+	fs.StringVar(&ic.MinReportSeverity, "min-report-severity", "",
+		wh("Drop the issues with a severity below this one (info < warning < error) from the output"))
+	fs.StringSliceVar(&ic.FailOnPaths, "fail-on-paths", nil,
+		wh("Globs of the paths whose issues set the exit code (e.g. newcode/**): the other issues are only reported"))
+	fs.StringVar(&ic.FirstSeenStore, "first-seen-store", "",
+		wh("Path of the JSON file recording when the fingerprint of each issue was first seen, to report these dates"))
+
+	fs.StringVar(&ic.TriagedFingerprints.URL, "triaged-fingerprints-url", "",
+		wh("URL returning the fingerprints of the issues triaged as won't fix, as a JSON array, to not report these issues"))
+	fs.BoolVar(&ic.TriagedFingerprints.FailClosed, "triaged-fingerprints-fail-closed", false,
+		wh("Stop the run if the triaged fingerprints can't be fetched, instead of reporting all the issues"))
 
 	fs.BoolVarP(&ic.Diff, "new", "n", false,
 		wh("Show only new issues: if there are unstaged changes or untracked files, only those changes "+
@@ -242,6 +383,28 @@ func initFlagSet(fs *pflag.FlagSet, cfg *config.Config, m *lintersdb.Manager, is
 		wh("Show only new issues created in git patch with file path `PATH`"))
 	fs.BoolVar(&ic.WholeFiles, "whole-files", false,
 		wh("Show issues in any part of update files (requires new-from-rev or new-from-patch)"))
+	fs.BoolVar(&ic.DiffByFunction, "diff-by-function", false,
+		wh("Show only issues in the functions changed since the new-from-rev revision, instead of the changed lines"))
+	fs.BoolVar(&ic.DiffAnnotate, "diff-annotate", false,
+		wh("Show the issues on the context lines of the diff too, and annotate the issues with their type of line: added or context"))
+	fs.BoolVar(&ic.DiffWorkingTree, "diff-working-tree", false,
+		wh("Show only the issues of the uncommitted changes: the staged and unstaged changes against HEAD, and the untracked files"))
+	fs.BoolVar(&ic.DiffStaged, "diff-staged", false,
+		wh("Show only the issues of the staged changes (git diff --cached), e.g. for a pre-commit hook"))
+	fs.BoolVar(&ic.SinceGreen, "since-green", false,
+		wh("Show only new issues created since the last run without issues, whose revision is recorded in the marker file"))
+	fs.StringVar(&ic.SinceGreenMarker, "since-green-marker", defaultSinceGreenMarker,
+		wh("Path of the marker file recording the revision of the last run without issues (requires since-green)"))
+	fs.StringSliceVar(&ic.BlameIncludeAuthors, "blame-include-authors", nil,
+		wh("Report only issues on lines last touched (according to git blame) by these authors' names or emails"))
+	fs.StringSliceVar(&ic.BlameExcludeAuthors, "blame-exclude-authors", nil,
+		wh("Don't report issues on lines last touched (according to git blame) by these authors' names or emails"))
+	fs.StringVar(&ic.BlameNewerThan, "blame-newer-than", "",
+		wh("Report only issues on lines committed (according to git blame) since this date, e.g. 2024-01-01"))
+	fs.StringVar(&ic.FileModifiedAfter, "file-modified-after", "",
+		wh("Report only issues of files modified (according to their mtime) after this date, e.g. 2024-01-01"))
+	fs.StringVar(&ic.FileModifiedBefore, "file-modified-before", "",
+		wh("Report only issues of files modified (according to their mtime) before this date, e.g. 2024-01-01"))
 	fs.BoolVar(&ic.NeedFix, "fix", false, "Fix found issues (if it's supported by the linter)")
 }
 
@@ -263,6 +426,9 @@ func (e *Executor) getConfigForCommandLine() (*config.Config, error) {
 	// affect main parsing by this parsing of only config option.
 	initFlagSet(fs, &cfg, e.DBManager, false)
 	initVersionFlagSet(fs, &cfg)
+	initFilesFlagSet(fs, &cfg)
+	initLintersCmdFlagSet(fs, &cfg)
+	initNolintFlagSet(fs, &cfg)
 
 	// Parse max options, even force version option: don't want
 	// to get access to Executor here: it's error-prone to use
@@ -353,25 +519,104 @@ func (e *Executor) runAnalysis(ctx context.Context, args []string) ([]result.Iss
 		e.reportData.AddLinter(lc.Name(), isEnabled, lc.EnabledByDefault)
 	}
 
-	lintCtx, err := e.contextLoader.Load(ctx, lintersToRun)
+	// read once: the confirmation pass of the fixes shares them.
+	inputs, err := lint.NewRunInputs(e.cfg, e.log.Child(logutils.DebugKeyRunner))
+	if err != nil {
+		return nil, err
+	}
+	inputs.DeferFirstSeenSave()
+
+	issues, err := e.runLinters(ctx, lintersToRun, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	fixer := processors.NewFixer(e.cfg, e.log, e.fileCache)
+	issues = fixer.Process(issues)
+
+	fixedFiles := fixer.FixedFiles()
+	if len(fixedFiles) == 0 {
+		return issues, inputs.SaveFirstSeen()
+	}
+
+	// confirm the fixes: the reported issues are the ones of the fixed code, e.g. left or introduced by the fixes.
+	e.log.Infof("Fixed %d files, running the linters again to confirm the fixes", len(fixedFiles))
+	for _, file := range fixedFiles {
+		e.lineCache.Forget(file)
+
+		// the packages hashes of the issues cache are computed from the files hashes.
+		if absPath, err := filepath.Abs(file); err == nil {
+			cache.ForgetFileHash(absPath)
+		}
+	}
+
+	e.cfg.Issues.NeedFix = false
+	defer func() { e.cfg.Issues.NeedFix = true }()
+
+	// new linters: the linters keep the issues of their run, e.g. gofmt.
+	dbManager := lintersdb.NewManager(e.cfg, e.log).WithCustomLinters()
+	lintersToRun, err = lintersdb.NewEnabledSet(dbManager, lintersdb.NewValidator(dbManager),
+		e.log.Child(logutils.DebugKeyLintersDB), e.cfg).GetOptimizedLinters()
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err = e.runLinters(ctx, lintersToRun, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return issues, inputs.SaveFirstSeen()
+}
+
+// runLinters loads the packages and runs the linters on them once, with the inputs of the run.
+func (e *Executor) runLinters(ctx context.Context, lintersToRun []*linter.Config, inputs *lint.RunInputs) ([]result.Issue, error) {
+	lintCtxs, err := e.contextLoader.LoadGoVersions(ctx, lintersToRun)
 	if err != nil {
 		return nil, errors.Wrap(err, "context loading failed")
 	}
-	lintCtx.Log = e.log.Child(logutils.DebugKeyLintersContext)
+
+	var pkgs []*gopackages.Package
+	for _, lintCtx := range lintCtxs {
+		lintCtx.Log = e.log.Child(logutils.DebugKeyLintersContext)
+		pkgs = append(pkgs, lintCtx.Packages...)
+	}
 
 	runner, err := lint.NewRunner(e.cfg, e.log.Child(logutils.DebugKeyRunner),
-		e.goenv, e.EnabledLintersSet, e.lineCache, e.DBManager, lintCtx.Packages)
+		e.goenv, e.EnabledLintersSet, e.lineCache, e.DBManager, pkgs, inputs)
 	if err != nil {
 		return nil, err
 	}
 
-	issues, err := runner.Run(ctx, lintersToRun, lintCtx)
+	runner.SetIssueFilter(e.issueFilter)
+
+	issues, err := runner.RunContexts(ctx, lintersToRun, lintCtxs)
+	e.linterDurations = runner.LinterDurations()
+	e.goAnalysisDuration = runner.GoAnalysisDuration()
+
+	if e.cfg.Output.ReportLinterStatuses {
+		byStatus := runner.LintersByStatus()
+		e.reportData.LinterStatuses = &report.LinterStatusesData{
+			Issues:  byStatus[lint.LinterStatusIssues],
+			Clean:   byStatus[lint.LinterStatusClean],
+			Errored: byStatus[lint.LinterStatusErrored],
+			Skipped: byStatus[lint.LinterStatusSkipped],
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
-	fixer := processors.NewFixer(e.cfg, e.log, e.fileCache)
-	return fixer.Process(issues), nil
+	e.reportData.IssuesLimit = nil // of the last run
+	if total, reached := runner.MaxTotalReached(); reached {
+		e.reportData.IssuesLimit = &report.IssuesLimitData{
+			Limit: e.cfg.Issues.MaxTotal,
+			Total: total,
+		}
+	}
+
+	return issues, nil
 }
 
 func (e *Executor) setOutputToDevNull() (savedStdout, savedStderr *os.File) {
@@ -387,12 +632,35 @@ func (e *Executor) setOutputToDevNull() (savedStdout, savedStderr *os.File) {
 }
 
 func (e *Executor) setExitCodeIfIssuesFound(issues []result.Issue) {
-	if len(issues) != 0 {
+	// all the issues are reported, only the ones in issues.fail-on-paths fail the run (see processors.FailOnPaths).
+	failingCount := 0
+	for i := range issues {
+		if !issues[i].NotFailing {
+			failingCount++
+		}
+	}
+
+	if failingCount != 0 {
 		e.exitCode = e.cfg.Run.ExitCodeIfIssuesFound
+	} else if len(issues) != 0 {
+		e.log.Infof("%d issues found outside of issues.fail-on-paths: not failing", len(issues))
 	}
 }
 
 func (e *Executor) runAndPrint(ctx context.Context, args []string) error {
+	startedAt := time.Now()
+
+	// the config file is validated by the reader, not the flags.
+	if err := e.cfg.Validate(); err != nil {
+		return err
+	}
+
+	// the outputs are validated before the (long) analysis.
+	targets, err := config.ParseOutputTargets(e.cfg.Output.Format)
+	if err != nil {
+		return err
+	}
+
 	if err := e.goenv.Discover(ctx); err != nil {
 		e.log.Warnf("Failed to discover go env: %s", err)
 	}
@@ -406,19 +674,29 @@ func (e *Executor) runAndPrint(ctx context.Context, args []string) error {
 		}()
 	}
 
+	greenRevision, err := e.applySinceGreen()
+	if err != nil {
+		return err
+	}
+
 	issues, err := e.runAnalysis(ctx, args)
 	if err != nil {
 		return err // XXX: don't loose type
 	}
 
-	formats := strings.Split(e.cfg.Output.Format, ",")
-	for _, format := range formats {
-		out := strings.SplitN(format, ":", 2)
-		if len(out) < 2 {
-			out = append(out, "")
+	if len(e.cfg.Output.LinterGroups) != 0 {
+		// the counts are computed on the final set of issues.
+		e.reportData.SetLinterGroups(e.getLinterGroups(), issues)
+	}
+
+	for _, target := range targets {
+		// the severities are set by the severity rules of the runner.
+		targetIssues, err := processors.NewSeverityFilter(target.Severities).Process(issues)
+		if err != nil {
+			return err
 		}
 
-		err := e.printReports(ctx, issues, out[1], out[0])
+		err = e.printReports(ctx, targetIssues, target.Path, target.Format)
 		if err != nil {
 			return err
 		}
@@ -426,12 +704,85 @@ func (e *Executor) runAndPrint(ctx context.Context, args []string) error {
 
 	e.setExitCodeIfIssuesFound(issues)
 
+	if e.cfg.Output.MetricsFile != "" {
+		if err := e.writeMetrics(issues, time.Since(startedAt)); err != nil {
+			return err
+		}
+	}
+
+	if e.cfg.Issues.SinceGreen {
+		e.recordGreenRevision(greenRevision, issues)
+	}
+
+	e.printLinterGroupsSummary()
+
 	e.fileCache.PrintStats(e.log)
 
 	return nil
 }
 
+// getLinterGroups returns the linter groups of the config with the linter names normalized (aliases).
+func (e *Executor) getLinterGroups() map[string][]string {
+	groups := map[string][]string{}
+	for name, linters := range e.cfg.Output.LinterGroups {
+		groups[name] = []string{}
+		for _, linterName := range linters {
+			lcs := e.DBManager.GetLinterConfigs(linterName)
+			if len(lcs) == 0 {
+				e.log.Warnf("Unknown linter %q in the linter group %q", linterName, name)
+				continue
+			}
+
+			for _, lc := range lcs {
+				groups[name] = append(groups[name], lc.Name())
+			}
+		}
+	}
+
+	return groups
+}
+
+func (e *Executor) printLinterGroupsSummary() {
+	if len(e.reportData.LinterGroups) == 0 {
+		return
+	}
+
+	counts := make([]string, 0, len(e.reportData.LinterGroups))
+	for _, group := range e.reportData.LinterGroups {
+		counts = append(counts, fmt.Sprintf("%s: %d", group.Name, group.IssuesCount))
+	}
+
+	fmt.Fprintf(logutils.StdErr, "Issues by linter group: %s\n", strings.Join(counts, ", "))
+}
+
+// writeMetrics writes the metrics of the run to the metrics file, in the Prometheus text format.
+func (e *Executor) writeMetrics(issues []result.Issue, runDuration time.Duration) error {
+	var b bytes.Buffer
+	if err := e.reportData.WritePrometheusMetrics(&b, issues, e.linterDurations, e.goAnalysisDuration, runDuration); err != nil {
+		return err
+	}
+
+	if err := fsutils.WriteFileAtomic(e.cfg.Output.MetricsFile, b.Bytes(), defaultFileMode); err != nil {
+		return fmt.Errorf("can't write the metrics file: %w", err)
+	}
+
+	return nil
+}
+
 func (e *Executor) printReports(ctx context.Context, issues []result.Issue, path, format string) error {
+	if format == config.OutFormatSQLite {
+		// the SQLite printer writes to the database file itself.
+		if path == "" || path == "stdout" || path == "stderr" {
+			return fmt.Errorf("output format %s requires a file path, e.g. %s:results.db", format, format)
+		}
+
+		if err := printers.NewSQLite(path).Print(ctx, issues); err != nil {
+			return fmt.Errorf("can't print %d issues: %s", len(issues), err)
+		}
+
+		return nil
+	}
+
 	w, shouldClose, err := e.createWriter(path)
 	if err != nil {
 		return fmt.Errorf("can't create output for %s: %w", path, err)
@@ -453,7 +804,10 @@ func (e *Executor) printReports(ctx context.Context, issues []result.Issue, path
 	}
 
 	if file, ok := w.(io.Closer); shouldClose && ok {
-		_ = file.Close()
+		// the error must be checked: a compressed output is only complete once closed.
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("can't close output %s: %w", path, err)
+		}
 	}
 
 	return nil
@@ -466,13 +820,42 @@ func (e *Executor) createWriter(path string) (io.Writer, bool, error) {
 	if path == "stderr" {
 		return logutils.StdErr, false, nil
 	}
+	compress := e.cfg.Output.Compress
+	if compress == "" && strings.HasSuffix(path, ".gz") {
+		compress = config.OutCompressGzip
+	}
+	if compress != "" && compress != config.OutCompressGzip {
+		return nil, false, fmt.Errorf("unknown output compression %q, only %q is supported", compress, config.OutCompressGzip)
+	}
+
 	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, defaultFileMode)
 	if err != nil {
 		return nil, false, err
 	}
+
+	if compress == config.OutCompressGzip {
+		return &gzipFileWriter{Writer: gzip.NewWriter(f), file: f}, true, nil
+	}
+
 	return f, true, nil
 }
 
+// gzipFileWriter compresses the output written to a file.
+type gzipFileWriter struct {
+	*gzip.Writer
+	file *os.File
+}
+
+// Close flushes the compressed data and closes the file.
+func (w *gzipFileWriter) Close() error {
+	if err := w.Writer.Close(); err != nil {
+		_ = w.file.Close()
+		return err
+	}
+
+	return w.file.Close()
+}
+
 func (e *Executor) createPrinter(format string, w io.Writer) (printers.Printer, error) {
 	var p printers.Printer
 	switch format {
@@ -480,10 +863,10 @@ func (e *Executor) createPrinter(format string, w io.Writer) (printers.Printer,
 		p = printers.NewJSON(&e.reportData, w)
 	case config.OutFormatColoredLineNumber, config.OutFormatLineNumber:
 		p = printers.NewText(e.cfg.Output.PrintIssuedLine,
-			format == config.OutFormatColoredLineNumber, e.cfg.Output.PrintLinterName,
+			format == config.OutFormatColoredLineNumber, e.cfg.Output.PrintLinterName, e.cfg.Output.ShowPackage, e.cfg.Output.ShowIndex,
 			e.log.Child(logutils.DebugKeyTextPrinter), w)
 	case config.OutFormatTab:
-		p = printers.NewTab(e.cfg.Output.PrintLinterName, e.log.Child(logutils.DebugKeyTabPrinter), w)
+		p = printers.NewTab(e.cfg.Output.PrintLinterName, e.cfg.Output.ShowPackage, e.log.Child(logutils.DebugKeyTabPrinter), w)
 	case config.OutFormatCheckstyle:
 		p = printers.NewCheckstyle(w)
 	case config.OutFormatCodeClimate:
@@ -491,9 +874,23 @@ func (e *Executor) createPrinter(format string, w io.Writer) (printers.Printer,
 	case config.OutFormatHTML:
 		p = printers.NewHTML(w)
 	case config.OutFormatJunitXML:
-		p = printers.NewJunitXML(w)
+		switch e.cfg.Output.JunitXMLGroupBy {
+		case config.JunitXMLGroupByFile:
+			p = printers.NewJunitXML(w)
+		case config.JunitXMLGroupByLinter:
+			p = printers.NewJunitXMLByLinter(w)
+		default:
+			return nil, fmt.Errorf("unknown junit-xml-group-by %q: must be %s or %s",
+				e.cfg.Output.JunitXMLGroupBy, config.JunitXMLGroupByFile, config.JunitXMLGroupByLinter)
+		}
 	case config.OutFormatGithubActions:
 		p = printers.NewGithub(w)
+	case config.OutFormatGrep:
+		p = printers.NewGrep(w)
+	case config.OutFormatNDJSON:
+		p = printers.NewNDJSON(w)
+	case config.OutFormatJSONGrouped:
+		p = printers.NewJSONGrouped(w)
 	default:
 		return nil, fmt.Errorf("unknown output format %s", format)
 	}
@@ -502,7 +899,15 @@ func (e *Executor) createPrinter(format string, w io.Writer) (printers.Printer,
 }
 
 // executeRun executes the 'run' CLI command, which runs the linters.
-func (e *Executor) executeRun(_ *cobra.Command, args []string) {
+func (e *Executor) executeRun(cmd *cobra.Command, args []string) {
+	if e.cfg.Run.PrintReproduce {
+		if err := e.printReproduce(logutils.StdOut, cmd, args); err != nil {
+			e.log.Errorf("Failed to print the reproduction: %s", err)
+			e.exitCode = exitcodes.Failure
+		}
+		return
+	}
+
 	needTrackResources := e.cfg.Run.IsVerbose || e.cfg.Run.PrintResourcesUsage
 	trackResourcesEndCh := make(chan struct{})
 	defer func() { // XXX: this defer must be before ctx.cancel defer
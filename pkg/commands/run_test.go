@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+)
+
+// writeOutput writes the content with a writer of createWriter, closed, and returns the content of the file.
+func writeOutput(t *testing.T, e *Executor, path, content string) []byte {
+	t.Helper()
+
+	w, shouldClose, err := e.createWriter(path)
+	require.NoError(t, err)
+	require.True(t, shouldClose)
+
+	_, err = io.WriteString(w, content)
+	require.NoError(t, err)
+	require.NoError(t, w.(io.Closer).Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return data
+}
+
+func gunzip(t *testing.T, data []byte) string {
+	t.Helper()
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	uncompressed, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	return string(uncompressed)
+}
+
+func TestCreateWriterCompression(t *testing.T) {
+	dir := t.TempDir()
+	const content = `{"Issues":[]}`
+
+	e := &Executor{cfg: config.NewDefault()}
+
+	path := filepath.Join(dir, "report.json")
+	assert.Equal(t, content, string(writeOutput(t, e, path, content)))
+
+	// the .gz suffix enables the compression.
+	path = filepath.Join(dir, "report.json.gz")
+	assert.Equal(t, content, gunzip(t, writeOutput(t, e, path, content)))
+
+	e.cfg.Output.Compress = config.OutCompressGzip
+	path = filepath.Join(dir, "report.xml")
+	assert.Equal(t, content, gunzip(t, writeOutput(t, e, path, content)))
+}
+
+func TestCreateWriterUnknownCompression(t *testing.T) {
+	e := &Executor{cfg: config.NewDefault()}
+	e.cfg.Output.Compress = "zstd"
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	_, _, err := e.createWriter(path)
+	assert.EqualError(t, err, `unknown output compression "zstd", only "gzip" is supported`)
+	assert.NoFileExists(t, path)
+}
+
+func TestAnalyzerConcurrencyFlag(t *testing.T) {
+	cfg := config.NewDefault()
+
+	fs := pflag.NewFlagSet("run", pflag.ContinueOnError)
+	initFlagSet(fs, cfg, lintersdb.NewManager(nil, nil), false)
+
+	require.NoError(t, fs.Parse([]string{"--analyzer-concurrency=2"}))
+	assert.Equal(t, 2, cfg.Run.AnalyzerConcurrency)
+
+	// the flags are validated by the run like the config file.
+	require.NoError(t, fs.Parse([]string{"--analyzer-concurrency=-1"}))
+	assert.Error(t, cfg.Validate())
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+)
+
+const defaultSinceGreenMarker = ".golangci-green"
+
+// applySinceGreen makes the run report only the issues created since the revision recorded in the marker file,
+// by diffing against this revision, and returns the current revision, to be recorded if the run is clean.
+// Without marker file, all the issues are reported.
+func (e *Executor) applySinceGreen() (string, error) {
+	ic := &e.cfg.Issues
+	if !ic.SinceGreen {
+		return "", nil
+	}
+
+	if ic.Diff || ic.DiffFromRevision != "" || ic.DiffPatchFilePath != "" || ic.DiffWorkingTree || ic.DiffStaged {
+		return "", errors.New("issues.since-green can't be combined with issues.new, issues.new-from-rev, issues.new-from-patch, " +
+			"issues.diff-working-tree or issues.diff-staged")
+	}
+
+	currentRevision, err := processors.CurrentRevision()
+	if err != nil {
+		return "", fmt.Errorf("can't get the current revision for since-green: %w", err)
+	}
+
+	content, err := os.ReadFile(ic.SinceGreenMarker)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("can't read since-green marker: %w", err)
+		}
+
+		e.log.Infof("No since-green marker %s: reporting all the issues", ic.SinceGreenMarker)
+		return currentRevision, nil
+	}
+
+	greenRevision := strings.TrimSpace(string(content))
+	if greenRevision == "" {
+		e.log.Warnf("Empty since-green marker %s: reporting all the issues", ic.SinceGreenMarker)
+		return currentRevision, nil
+	}
+
+	e.log.Infof("Reporting the issues created since the last green revision %s", greenRevision)
+	ic.DiffFromRevision = greenRevision
+
+	return currentRevision, nil
+}
+
+// recordGreenRevision records the revision in the since-green marker file if the run is clean:
+// no issues and no logged errors.
+func (e *Executor) recordGreenRevision(revision string, issues []result.Issue) {
+	if len(issues) != 0 || e.reportData.Error != "" || revision == "" {
+		return
+	}
+
+	err := os.WriteFile(e.cfg.Issues.SinceGreenMarker, []byte(revision+"\n"), 0o644)
+	if err != nil {
+		e.log.Warnf("Can't write since-green marker: %s", err)
+		return
+	}
+
+	e.log.Infof("Recorded the green revision %s in %s", revision, e.cfg.Issues.SinceGreenMarker)
+}
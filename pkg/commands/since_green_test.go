@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// newSinceGreenTestExecutor returns an executor with since-green enabled, in a new git repository with one commit,
+// and the revision of this commit.
+func newSinceGreenTestExecutor(t *testing.T) (*Executor, string) {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repo := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+
+	git("init", "-q")
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "foo.go"), []byte("package foo\n"), 0o600))
+	git("add", ".")
+	git("commit", "-q", "-m", "init")
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(repo))
+	t.Cleanup(func() { require.NoError(t, os.Chdir(wd)) })
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	log.SetLevel(logutils.LogLevelError)
+
+	cfg := config.NewDefault()
+	cfg.Issues.SinceGreen = true
+	cfg.Issues.SinceGreenMarker = filepath.Join(repo, defaultSinceGreenMarker)
+
+	return &Executor{cfg: cfg, log: log}, git("rev-parse", "HEAD")
+}
+
+func TestSinceGreen(t *testing.T) {
+	e, revision := newSinceGreenTestExecutor(t)
+
+	// without marker, all the issues are reported.
+	currentRevision, err := e.applySinceGreen()
+	require.NoError(t, err)
+	assert.Equal(t, revision, currentRevision)
+	assert.Empty(t, e.cfg.Issues.DiffFromRevision)
+
+	// a run with issues isn't green.
+	e.recordGreenRevision(currentRevision, []result.Issue{{FromLinter: "linter"}})
+	assert.NoFileExists(t, e.cfg.Issues.SinceGreenMarker)
+
+	e.recordGreenRevision(currentRevision, nil)
+	content, err := os.ReadFile(e.cfg.Issues.SinceGreenMarker)
+	require.NoError(t, err)
+	assert.Equal(t, revision+"\n", string(content))
+
+	// the next run reports the issues created since the green revision.
+	currentRevision, err = e.applySinceGreen()
+	require.NoError(t, err)
+	assert.Equal(t, revision, currentRevision)
+	assert.Equal(t, revision, e.cfg.Issues.DiffFromRevision)
+}
+
+func TestSinceGreenNotRecordedOnError(t *testing.T) {
+	e, revision := newSinceGreenTestExecutor(t)
+
+	e.reportData.Error = "can't run linter"
+	e.recordGreenRevision(revision, nil)
+	assert.NoFileExists(t, e.cfg.Issues.SinceGreenMarker)
+}
+
+func TestSinceGreenEmptyMarker(t *testing.T) {
+	e, revision := newSinceGreenTestExecutor(t)
+	require.NoError(t, os.WriteFile(e.cfg.Issues.SinceGreenMarker, []byte("\n"), 0o600))
+
+	currentRevision, err := e.applySinceGreen()
+	require.NoError(t, err)
+	assert.Equal(t, revision, currentRevision)
+	assert.Empty(t, e.cfg.Issues.DiffFromRevision)
+}
+
+func TestSinceGreenWithDiff(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.Issues.SinceGreen = true
+	cfg.Issues.DiffFromRevision = "HEAD~1"
+
+	_, err := (&Executor{cfg: cfg}).applySinceGreen()
+	assert.ErrorContains(t, err, "issues.since-green can't be combined with")
+}
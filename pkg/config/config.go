@@ -1,6 +1,8 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"strings"
 
@@ -20,6 +22,9 @@ type Config struct {
 	Issues          Issues
 	Severity        Severity
 	Version         Version
+	Files           Files
+	LintersCmd      LintersCmd `mapstructure:"linters-cmd"`
+	Nolint          Nolint
 
 	InternalCmdTest bool `mapstructure:"internal-cmd-test"` // Option is used only for testing golangci-lint command, don't use it
 	InternalTest    bool // Option is used only for testing golangci-lint code, don't use it
@@ -30,6 +35,70 @@ func (c *Config) GetConfigDir() string {
 	return c.cfgDir
 }
 
+// Validate checks the values of the options, wherever they are set:
+// it runs on the config file, and on the configuration transformed by the programs embedding golangci-lint.
+func (c *Config) Validate() error {
+	if c.Run.AnalyzerConcurrency < 0 {
+		return fmt.Errorf("run.analyzer-concurrency must be positive or 0 (GOMAXPROCS), got %d", c.Run.AnalyzerConcurrency)
+	}
+	if c.Run.SourceReadConcurrency < 0 {
+		return fmt.Errorf("run.source-read-concurrency must be positive or 0, got %d", c.Run.SourceReadConcurrency)
+	}
+	if c.Output.SourceTabWidth < 0 {
+		return fmt.Errorf("output.source-tab-width must be positive or 0, got %d", c.Output.SourceTabWidth)
+	}
+	if c.Output.PathBase != "" && c.Issues.NeedFix {
+		return errors.New("output.path-base can't be combined with issues.fix: the fixes need the paths of the files")
+	}
+	if c.Issues.MaxFiles < 0 {
+		return fmt.Errorf("issues.max-files must be positive or 0, got %d", c.Issues.MaxFiles)
+	}
+	if c.Issues.MaxTotal < 0 {
+		return fmt.Errorf("issues.max-total must be positive or 0, got %d", c.Issues.MaxTotal)
+	}
+
+	if c.Issues.ConfidenceTop < 0 {
+		return fmt.Errorf("issues.confidence-top must be positive or 0, got %d", c.Issues.ConfidenceTop)
+	}
+	if c.Issues.MinColumn < 0 {
+		return fmt.Errorf("issues.min-column must be positive or 0, got %d", c.Issues.MinColumn)
+	}
+	for linter, column := range c.Issues.MinColumnPerLinter {
+		if column < 0 {
+			return fmt.Errorf("issues.min-column-per-linter: the minimum column of %s must be positive or 0, got %d", linter, column)
+		}
+	}
+
+	if c.Issues.PackageBudget < 0 {
+		return fmt.Errorf("issues.package-budget must be positive or 0, got %d", c.Issues.PackageBudget)
+	}
+	for linter, weight := range c.Issues.LinterWeights {
+		if weight <= 0 {
+			return fmt.Errorf("issues.linter-weights: the weight of %s must be positive, got %d", linter, weight)
+		}
+	}
+	for i, rule := range c.Issues.ExcludeRules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("error in exclude rule #%d: %v", i, err)
+		}
+	}
+	if err := validateCustomDefaultExcludes(c.Issues.CustomDefaultExcludes); err != nil {
+		return err
+	}
+	if len(c.Severity.Rules) > 0 && c.Severity.Default == "" {
+		return errors.New("can't set severity rule option: no default severity defined")
+	}
+	for i, rule := range c.Severity.Rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("error in severity rule #%d: %v", i, err)
+		}
+	}
+	if err := c.LintersSettings.Govet.Validate(); err != nil {
+		return fmt.Errorf("error in govet config: %v", err)
+	}
+	return nil
+}
+
 func NewDefault() *Config {
 	return &Config{
 		LintersSettings: defaultLintersSettings,
@@ -40,6 +109,24 @@ type Version struct {
 	Format string `mapstructure:"format"`
 }
 
+type LintersCmd struct {
+	JSON bool `mapstructure:"json"`
+}
+
+type Files struct {
+	Linter string `mapstructure:"linter"`
+}
+
+type Nolint struct {
+	Prune bool `mapstructure:"prune"`
+	Write bool `mapstructure:"write"`
+
+	Directives   []string `mapstructure:"directives"`
+	ExternalFile string   `mapstructure:"external-file"`
+
+	WarnUnknownLinters bool `mapstructure:"warn-unknown-linters"`
+}
+
 func IsGreaterThanOrEqualGo118(v string) bool {
 	v1, err := hcversion.NewVersion(strings.TrimPrefix(v, "go"))
 	if err != nil {
@@ -0,0 +1,18 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidateAnalyzerConcurrency(t *testing.T) {
+	cfg := NewDefault()
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Run.AnalyzerConcurrency = 2
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Run.AnalyzerConcurrency = -1
+	assert.EqualError(t, cfg.Validate(), "run.analyzer-concurrency must be positive or 0 (GOMAXPROCS), got -1")
+}
@@ -1,12 +1,17 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"regexp"
 )
 
 const excludeRuleMinConditionsCount = 2
 
+// envVarRefRe matches the `${VAR}` references to environment variables.
+var envVarRefRe = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)}`)
+
 var DefaultExcludePatterns = []ExcludePattern{
 	{
 		ID: "EXC0001",
@@ -104,29 +109,168 @@ var DefaultExcludePatterns = []ExcludePattern{
 }
 
 type Issues struct {
-	IncludeDefaultExcludes []string      `mapstructure:"include"`
-	ExcludeCaseSensitive   bool          `mapstructure:"exclude-case-sensitive"`
-	ExcludePatterns        []string      `mapstructure:"exclude"`
-	ExcludeRules           []ExcludeRule `mapstructure:"exclude-rules"`
-	UseDefaultExcludes     bool          `mapstructure:"exclude-use-default"`
+	IncludeDefaultExcludes []string         `mapstructure:"include"`
+	CustomDefaultExcludes  []ExcludePattern `mapstructure:"custom-default-excludes"`
+	ExcludeCaseSensitive   bool             `mapstructure:"exclude-case-sensitive"`
+	ExcludePatterns        []string         `mapstructure:"exclude"`
+	ExcludeRules           []ExcludeRule    `mapstructure:"exclude-rules"`
+	UseDefaultExcludes     bool             `mapstructure:"exclude-use-default"`
+
+	WarnUnusedExcludeRules bool `mapstructure:"warn-unused-exclude-rules"`
+
+	UseLinterRecommendedExcludes bool `mapstructure:"use-linter-recommended-excludes"`
+	ExcludeNoopFixes             bool `mapstructure:"exclude-noop-fixes"`
+
+	InlineGeneratedMarker string `mapstructure:"inline-generated-marker"`
+
+	// GeneratedFilesLinters are the linters whose issues are reported in the generated files too.
+	GeneratedFilesLinters []string `mapstructure:"generated-files-linters"`
+
+	// MergeRangeLinters are the linters reporting the start and the end of a range as two issues.
+	MergeRangeLinters []string `mapstructure:"merge-range-linters"`
+
+	RollupConsecutive bool `mapstructure:"rollup-consecutive"`
+
+	SidecarAllowlist             bool `mapstructure:"sidecar-allowlist"`
+	SidecarAllowlistReportUnused bool `mapstructure:"sidecar-allowlist-report-unused"`
+
+	SkipFilesLargerThan  string `mapstructure:"skip-files-larger-than"`
+	InvalidPositions     string `mapstructure:"invalid-positions"`
+	FollowLineDirectives bool   `mapstructure:"follow-line-directives"`
 
 	MaxIssuesPerLinter int `mapstructure:"max-issues-per-linter"`
 	MaxSameIssues      int `mapstructure:"max-same-issues"`
+	MaxDistinctLinters int `mapstructure:"max-distinct-linters"`
+	MaxFiles           int `mapstructure:"max-files"`
+	ReportThreshold    int `mapstructure:"report-threshold"`
+	MaxTotal           int `mapstructure:"max-total"`
+
+	// MinColumn is the minimum column of the issues, overridden per linter by MinColumnPerLinter.
+	MinColumn          int            `mapstructure:"min-column"`
+	MinColumnPerLinter map[string]int `mapstructure:"min-column-per-linter"`
+
+	// AllowedDeprecatedSymbols are the deprecated symbols whose staticcheck SA1019 issues are dropped.
+	AllowedDeprecatedSymbols []string `mapstructure:"allowed-deprecated-symbols"`
+
+	PackageBudget int            `mapstructure:"package-budget"`
+	LinterWeights map[string]int `mapstructure:"linter-weights"`
+
+	ConfidenceRanking bool `mapstructure:"confidence-ranking"`
+	ConfidenceTop     int  `mapstructure:"confidence-top"`
+
+	CollapseTypecheck     bool   `mapstructure:"collapse-typecheck"`
+	MinReportedComplexity int    `mapstructure:"min-reported-complexity"`
+	DedupTestVariants     bool   `mapstructure:"dedup-test-variants"`
+	DedupSymlinks         bool   `mapstructure:"dedup-symlinks"`
+	NormalizePathCase     string `mapstructure:"normalize-path-case"`
+	UniquePositions       bool   `mapstructure:"unique-positions"`
+	MinReportSeverity     string `mapstructure:"min-report-severity"`
+
+	// KnownCompilerDiagnostics is the path of the output of the compiler (`-` for stdin).
+	KnownCompilerDiagnostics string `mapstructure:"known-compiler-diagnostics"`
+
+	BlameIncludeAuthors []string `mapstructure:"blame-include-authors"`
+	BlameExcludeAuthors []string `mapstructure:"blame-exclude-authors"`
+	BlameNewerThan      string   `mapstructure:"blame-newer-than"`
+
+	FileModifiedAfter  string `mapstructure:"file-modified-after"`
+	FileModifiedBefore string `mapstructure:"file-modified-before"`
 
 	DiffFromRevision  string `mapstructure:"new-from-rev"`
 	DiffPatchFilePath string `mapstructure:"new-from-patch"`
 	WholeFiles        bool   `mapstructure:"whole-files"`
 	Diff              bool   `mapstructure:"new"`
+	DiffByFunction    bool   `mapstructure:"diff-by-function"`
+	DiffAnnotate      bool   `mapstructure:"diff-annotate"`
+	DiffWorkingTree   bool   `mapstructure:"diff-working-tree"`
+	DiffStaged        bool   `mapstructure:"diff-staged"`
+	SinceGreen        bool   `mapstructure:"since-green"`
+	SinceGreenMarker  string `mapstructure:"since-green-marker"`
+
+	// FailOnPaths are the globs of the paths whose issues set the exit code: the other issues are only reported.
+	FailOnPaths []string `mapstructure:"fail-on-paths"`
+
+	// FirstSeenStore is the path of the JSON file of the dates when the fingerprints of the issues were first seen.
+	FirstSeenStore string `mapstructure:"first-seen-store"`
 
 	NeedFix bool `mapstructure:"fix"`
+
+	TriagedFingerprints TriagedFingerprints `mapstructure:"triaged-fingerprints"`
+}
+
+// TriagedFingerprints is the HTTP endpoint returning the fingerprints of the issues triaged as "won't fix",
+// as a JSON array of strings.
+type TriagedFingerprints struct {
+	URL string `mapstructure:"url"`
+
+	// FailClosed stops the run if the fingerprints can't be fetched, instead of reporting all the issues.
+	FailClosed bool `mapstructure:"fail-closed"`
 }
 
 type ExcludeRule struct {
 	BaseRule `mapstructure:",squash"`
+
+	// CheckIDs are the identifiers of the checks of the issues, e.g. `SA1019` (see result.Issue.CheckID).
+	CheckIDs []string `mapstructure:"check-ids"`
+
+	// All explicitly excludes all the issues of the path, whatever their linters and texts.
+	All bool `mapstructure:"all"`
 }
 
 func (e ExcludeRule) Validate() error {
-	return e.BaseRule.Validate(excludeRuleMinConditionsCount)
+	if !e.All {
+		if len(e.CheckIDs) != 0 {
+			// the check IDs are one of the conditions.
+			return e.BaseRule.Validate(excludeRuleMinConditionsCount - 1)
+		}
+		return e.BaseRule.Validate(excludeRuleMinConditionsCount)
+	}
+
+	// a rule excluding everything must be restricted to a path, and only to a path.
+	if e.Path == "" {
+		return errors.New("all: true requires a path")
+	}
+	if len(e.Linters) > 0 || e.Text != "" || e.Source != "" || len(e.CheckIDs) > 0 {
+		return errors.New("all: true excludes all the issues of the path: text, source, linters and check-ids can't be set")
+	}
+
+	return e.BaseRule.Validate(1)
+}
+
+// ExpandEnv replaces the `${VAR}` references to environment variables in the path and the text of the rule.
+// The values are quoted to be matched literally by the regular expressions.
+func (e *ExcludeRule) ExpandEnv() error {
+	var err error
+
+	if e.Path, err = expandEnvRefs(e.Path); err != nil {
+		return fmt.Errorf("invalid path: %w", err)
+	}
+
+	if e.Text, err = expandEnvRefs(e.Text); err != nil {
+		return fmt.Errorf("invalid text: %w", err)
+	}
+
+	return nil
+}
+
+func expandEnvRefs(value string) (string, error) {
+	var err error
+
+	expanded := envVarRefRe.ReplaceAllStringFunc(value, func(ref string) string {
+		name := envVarRefRe.FindStringSubmatch(ref)[1]
+
+		envValue, ok := os.LookupEnv(name)
+		if !ok {
+			if err == nil {
+				err = fmt.Errorf("environment variable %s is not defined", name)
+			}
+			return ref
+		}
+
+		return regexp.QuoteMeta(envValue)
+	})
+
+	return expanded, err
 }
 
 type BaseRule struct {
@@ -174,10 +318,23 @@ func validateOptionalRegex(value string) error {
 }
 
 type ExcludePattern struct {
-	ID      string
-	Pattern string
-	Linter  string
-	Why     string
+	ID      string `mapstructure:"id"`
+	Pattern string `mapstructure:"pattern"`
+	Linter  string `mapstructure:"linter"` // empty: all the linters (custom default excludes only)
+	Why     string `mapstructure:"why"`
+}
+
+func (p ExcludePattern) Validate() error {
+	if p.ID == "" {
+		return errors.New("id is required")
+	}
+	if p.Pattern == "" {
+		return errors.New("pattern is required")
+	}
+	if _, err := regexp.Compile(p.Pattern); err != nil {
+		return fmt.Errorf("invalid pattern: %w", err)
+	}
+	return nil
 }
 
 func GetDefaultExcludePatternsStrings() []string {
@@ -190,13 +347,29 @@ func GetDefaultExcludePatternsStrings() []string {
 
 // TODO(ldez): this behavior must be changed in v2, because this is confusing.
 func GetExcludePatterns(include []string) []ExcludePattern {
+	return filterExcludePatterns(DefaultExcludePatterns, include)
+}
+
+// AllDefaultExcludePatterns returns the built-in default excludes followed by the custom ones.
+func (i *Issues) AllDefaultExcludePatterns() []ExcludePattern {
+	ret := make([]ExcludePattern, 0, len(DefaultExcludePatterns)+len(i.CustomDefaultExcludes))
+	ret = append(ret, DefaultExcludePatterns...)
+	return append(ret, i.CustomDefaultExcludes...)
+}
+
+// GetDefaultExcludePatterns returns the built-in and custom default excludes which are not included back.
+func (i *Issues) GetDefaultExcludePatterns() []ExcludePattern {
+	return filterExcludePatterns(i.AllDefaultExcludePatterns(), i.IncludeDefaultExcludes)
+}
+
+func filterExcludePatterns(patterns []ExcludePattern, include []string) []ExcludePattern {
 	includeMap := make(map[string]struct{}, len(include))
 	for _, inc := range include {
 		includeMap[inc] = struct{}{}
 	}
 
 	var ret []ExcludePattern
-	for _, p := range DefaultExcludePatterns {
+	for _, p := range patterns {
 		if _, ok := includeMap[p.ID]; !ok {
 			ret = append(ret, p)
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetExcludePatterns(t *testing.T) {
@@ -34,3 +35,171 @@ func TestGetExcludePatterns(t *testing.T) {
 		assert.True(t, inDefaultExc, fmt.Sprintf("%s must appear inside DefaultExcludePatterns.", p.ID))
 	}
 }
+
+func TestIssuesGetDefaultExcludePatterns(t *testing.T) {
+	custom := ExcludePattern{ID: "ORG0001", Pattern: "is not checked", Linter: "errcheck"}
+
+	issues := Issues{CustomDefaultExcludes: []ExcludePattern{custom}}
+	patterns := issues.GetDefaultExcludePatterns()
+	require.Len(t, patterns, len(DefaultExcludePatterns)+1)
+	assert.Equal(t, custom, patterns[len(patterns)-1])
+
+	issues.IncludeDefaultExcludes = []string{"ORG0001", DefaultExcludePatterns[0].ID}
+	patterns = issues.GetDefaultExcludePatterns()
+	assert.Equal(t, DefaultExcludePatterns[1:], patterns)
+}
+
+func TestValidateCustomDefaultExcludes(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		patterns []ExcludePattern
+		expected string
+	}{
+		{
+			desc:     "valid",
+			patterns: []ExcludePattern{{ID: "ORG0001", Pattern: "a"}, {ID: "ORG0002", Pattern: "b", Linter: "errcheck"}},
+		},
+		{
+			desc:     "no id",
+			patterns: []ExcludePattern{{Pattern: "a"}},
+			expected: "error in custom default exclude #0: id is required",
+		},
+		{
+			desc:     "no pattern",
+			patterns: []ExcludePattern{{ID: "ORG0001"}},
+			expected: "error in custom default exclude #0: pattern is required",
+		},
+		{
+			desc:     "invalid pattern",
+			patterns: []ExcludePattern{{ID: "ORG0001", Pattern: "("}},
+			expected: "error in custom default exclude #0: invalid pattern: error parsing regexp: missing closing ): `(`",
+		},
+		{
+			desc:     "duplicated id",
+			patterns: []ExcludePattern{{ID: "ORG0001", Pattern: "a"}, {ID: "ORG0001", Pattern: "b"}},
+			expected: `error in custom default exclude #1: duplicated id "ORG0001"`,
+		},
+		{
+			desc:     "built-in id",
+			patterns: []ExcludePattern{{ID: "EXC0001", Pattern: "a"}},
+			expected: `error in custom default exclude #0: duplicated id "EXC0001"`,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			err := validateCustomDefaultExcludes(test.patterns)
+			if test.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestExcludeRuleExpandEnv(t *testing.T) {
+	t.Setenv("GOLANGCI_TEST_BUILD_ROOT", "/ci/build.1")
+
+	rule := ExcludeRule{BaseRule: BaseRule{
+		Path: "^${GOLANGCI_TEST_BUILD_ROOT}/generated/",
+		Text: "${GOLANGCI_TEST_BUILD_ROOT}$",
+	}}
+
+	require.NoError(t, rule.ExpandEnv())
+	assert.Equal(t, `^/ci/build\.1/generated/`, rule.Path)
+	assert.Equal(t, `/ci/build\.1$`, rule.Text)
+}
+
+func TestExcludeRuleExpandEnvUndefined(t *testing.T) {
+	rule := ExcludeRule{BaseRule: BaseRule{
+		Path: "${GOLANGCI_TEST_UNDEFINED_VAR}/generated/",
+	}}
+
+	err := rule.ExpandEnv()
+	require.EqualError(t, err, "invalid path: environment variable GOLANGCI_TEST_UNDEFINED_VAR is not defined")
+}
+
+func TestExcludeRuleValidateAll(t *testing.T) {
+	testCases := []struct {
+		desc string
+		rule ExcludeRule
+		err  string
+	}{
+		{
+			desc: "all issues of a path",
+			rule: ExcludeRule{BaseRule: BaseRule{Path: "legacy/"}, All: true},
+		},
+		{
+			desc: "path only without all",
+			rule: ExcludeRule{BaseRule: BaseRule{Path: "legacy/"}},
+			err:  "at least 2 of (text, source, path, linters) should be set",
+		},
+		{
+			desc: "all without path",
+			rule: ExcludeRule{All: true},
+			err:  "all: true requires a path",
+		},
+		{
+			desc: "all with linters",
+			rule: ExcludeRule{BaseRule: BaseRule{Path: "legacy/", Linters: []string{"gosec"}}, All: true},
+			err:  "all: true excludes all the issues of the path: text, source, linters and check-ids can't be set",
+		},
+		{
+			desc: "all with invalid path",
+			rule: ExcludeRule{BaseRule: BaseRule{Path: "legacy/("}, All: true},
+			err:  "invalid path regex: error parsing regexp: missing closing ): `legacy/(`",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.rule.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestExcludeRuleValidateCheckIDs(t *testing.T) {
+	testCases := []struct {
+		desc string
+		rule ExcludeRule
+		err  string
+	}{
+		{
+			desc: "check IDs of a linter",
+			rule: ExcludeRule{BaseRule: BaseRule{Linters: []string{"staticcheck"}}, CheckIDs: []string{"SA1019"}},
+		},
+		{
+			desc: "check IDs only",
+			rule: ExcludeRule{CheckIDs: []string{"SA1019"}},
+			err:  "at least 1 of (text, source, path, linters) should be set",
+		},
+		{
+			desc: "all with check IDs",
+			rule: ExcludeRule{BaseRule: BaseRule{Path: "legacy/"}, CheckIDs: []string{"SA1019"}, All: true},
+			err:  "all: true excludes all the issues of the path: text, source, linters and check-ids can't be set",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.rule.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
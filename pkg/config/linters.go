@@ -8,4 +8,7 @@ type Linters struct {
 	Fast       bool
 
 	Presets []string
+
+	// Only is the name of the single linter to run, bypassing all the options above.
+	Only string
 }
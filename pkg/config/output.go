@@ -1,5 +1,10 @@
 package config
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
 	OutFormatJSON              = "json"
 	OutFormatLineNumber        = "line-number"
@@ -10,6 +15,22 @@ const (
 	OutFormatHTML              = "html"
 	OutFormatJunitXML          = "junit-xml"
 	OutFormatGithubActions     = "github-actions"
+	OutFormatSQLite            = "sqlite"
+	OutFormatGrep              = "grep"
+	OutFormatNDJSON            = "ndjson"
+	OutFormatJSONGrouped       = "json-grouped"
+)
+
+const OutCompressGzip = "gzip"
+
+const (
+	JunitXMLGroupByFile   = "file"
+	JunitXMLGroupByLinter = "linter"
+)
+
+const (
+	FingerprintModePosition = "position"
+	FingerprintModeContent  = "content"
 )
 
 var OutFormats = []string{
@@ -22,15 +43,117 @@ var OutFormats = []string{
 	OutFormatHTML,
 	OutFormatJunitXML,
 	OutFormatGithubActions,
+	OutFormatSQLite,
+	OutFormatGrep,
+	OutFormatNDJSON,
+	OutFormatJSONGrouped,
+}
+
+// MessageTranslation replaces the messages matching the regular expression Pattern by Template,
+// where the capture groups are expanded (`$1`, `${name}`).
+type MessageTranslation struct {
+	Pattern  string `mapstructure:"pattern"`
+	Template string `mapstructure:"template"`
 }
 
 type Output struct {
-	Format              string
-	Color               string
-	PrintIssuedLine     bool   `mapstructure:"print-issued-lines"`
-	PrintLinterName     bool   `mapstructure:"print-linter-name"`
-	UniqByLine          bool   `mapstructure:"uniq-by-line"`
-	SortResults         bool   `mapstructure:"sort-results"`
-	PrintWelcomeMessage bool   `mapstructure:"print-welcome"`
-	PathPrefix          string `mapstructure:"path-prefix"`
+	Format               string
+	Color                string
+	PrintIssuedLine      bool     `mapstructure:"print-issued-lines"`
+	PrintLinterName      bool     `mapstructure:"print-linter-name"`
+	ShowPackage          bool     `mapstructure:"show-package"`
+	ShowIndex            bool     `mapstructure:"show-index"`
+	SourceTabWidth       int      `mapstructure:"source-tab-width"`
+	IncludeOffsets       bool     `mapstructure:"include-offsets"`
+	IncludeEnclosingFunc bool     `mapstructure:"include-enclosing-func"`
+	UniqByLine           bool     `mapstructure:"uniq-by-line"`
+	SortResults          bool     `mapstructure:"sort-results"`
+	LowPriorityPaths     []string `mapstructure:"low-priority-paths"`
+	MetricsFile          string   `mapstructure:"metrics-file"`
+	PrintWelcomeMessage  bool     `mapstructure:"print-welcome"`
+	PathPrefix           string   `mapstructure:"path-prefix"`
+	PathBase             string   `mapstructure:"path-base"`
+	FingerprintMode      string   `mapstructure:"fingerprint-mode"`
+	Compress             string   `mapstructure:"compress"`
+	JunitXMLGroupBy      string   `mapstructure:"junit-xml-group-by"`
+
+	LinterGroups map[string][]string `mapstructure:"linter-groups"`
+
+	MessageTranslations []MessageTranslation `mapstructure:"message-translations"`
+
+	LinterMessagePrefix map[string]string `mapstructure:"linter-message-prefix"`
+
+	SplitCheckIDs  bool              `mapstructure:"split-check-ids"`
+	CheckIDFormats map[string]string `mapstructure:"check-id-formats"`
+
+	ReportLinterStatuses bool `mapstructure:"report-linter-statuses"`
+}
+
+// OutputTargetOptionSeverity is the option of an output target keeping only the issues of these severities,
+// separated by `|`: e.g. `json:errors.json:severity=error`.
+const OutputTargetOptionSeverity = "severity"
+
+// OutputTarget is one of the comma-separated outputs of Output.Format: `format[:path][:option=value...]`.
+type OutputTarget struct {
+	Format string
+	Path   string // empty: stdout
+
+	// Severities are the severities of the issues to print, all the issues if empty.
+	Severities []string
+}
+
+// ParseOutputTargets parses Output.Format.
+func ParseOutputTargets(format string) ([]OutputTarget, error) {
+	var targets []OutputTarget
+	for _, spec := range strings.Split(format, ",") {
+		target, err := parseOutputTarget(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid output %q: %w", spec, err)
+		}
+
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+func parseOutputTarget(spec string) (OutputTarget, error) {
+	parts := strings.Split(spec, ":")
+	target := OutputTarget{Format: parts[0]}
+
+	// the options are the trailing parts: the path can contain colons (e.g. on Windows).
+	end := len(parts)
+	for end > 1 {
+		part := parts[end-1]
+		if part == OutputTargetOptionSeverity {
+			return OutputTarget{}, fmt.Errorf("option %s requires a value, e.g. %s=error", part, part)
+		}
+
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			break
+		}
+
+		if key != OutputTargetOptionSeverity {
+			return OutputTarget{}, fmt.Errorf("unknown option %q, only %q is supported", key, OutputTargetOptionSeverity)
+		}
+
+		if target.Severities != nil {
+			return OutputTarget{}, fmt.Errorf("option %s is set several times", key)
+		}
+
+		for _, severity := range strings.Split(value, "|") {
+			if severity == "" {
+				return OutputTarget{}, fmt.Errorf("empty severity in %q", part)
+			}
+
+			target.Severities = append(target.Severities, severity)
+		}
+
+		end--
+	}
+
+	target.Path = strings.Join(parts[1:end], ":")
+
+	return target, nil
 }
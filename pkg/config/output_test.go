@@ -0,0 +1,42 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOutputTargets(t *testing.T) {
+	targets, err := ParseOutputTargets(`json:errors.json:severity=error,checkstyle:warnings.xml:severity=warning|info,` +
+		`colored-line-number,tab:stderr,json:severity=error,json:C:\out\report.json`)
+	require.NoError(t, err)
+
+	expected := []OutputTarget{
+		{Format: OutFormatJSON, Path: "errors.json", Severities: []string{"error"}},
+		{Format: OutFormatCheckstyle, Path: "warnings.xml", Severities: []string{"warning", "info"}},
+		{Format: OutFormatColoredLineNumber},
+		{Format: OutFormatTab, Path: "stderr"},
+		{Format: OutFormatJSON, Severities: []string{"error"}},
+		{Format: OutFormatJSON, Path: `C:\out\report.json`},
+	}
+	assert.Equal(t, expected, targets)
+}
+
+func TestParseOutputTargetsInvalid(t *testing.T) {
+	testCases := []string{
+		"json:errors.json:severity",
+		"json:errors.json:severity=",
+		"json:errors.json:severity=error|",
+		"json:errors.json:level=error",
+		"json:errors.json:severity=error:severity=warning",
+	}
+
+	for _, format := range testCases {
+		format := format
+		t.Run(format, func(t *testing.T) {
+			_, err := ParseOutputTargets(format)
+			assert.Error(t, err)
+		})
+	}
+}
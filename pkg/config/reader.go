@@ -1,8 +1,10 @@
 package config
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -44,6 +46,10 @@ func (r *FileReader) Read() error {
 		return fmt.Errorf("can't parse --config option: %s", err)
 	}
 
+	if isRemoteConfig(configFile) {
+		return r.parseRemoteConfig(configFile)
+	}
+
 	if configFile != "" {
 		viper.SetConfigFile(configFile)
 
@@ -83,10 +89,45 @@ func (r *FileReader) parseConfig() error {
 	}
 	r.cfg.cfgDir = usedConfigDir
 
+	return r.unmarshalConfig()
+}
+
+// parseRemoteConfig reads the config fetched from an HTTP(S) URL.
+// The config is fetched once per run and read from memory:
+// the paths relative to the config directory are relative to the working directory.
+func (r *FileReader) parseRemoteConfig(configURL string) error {
+	data, err := fetchRemoteConfig(&http.Client{Timeout: remoteConfigTimeout}, configURL, os.Getenv(envConfigToken))
+	if err != nil {
+		return fmt.Errorf("can't fetch config from %s: %s", configURL, err)
+	}
+
+	viper.SetConfigType(remoteConfigType(configURL))
+	if err := viper.ReadConfig(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("can't read viper config: %s", err)
+	}
+
+	r.log.Infof("Used config %s", configURL)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return errors.New("can't get working directory")
+	}
+	r.cfg.cfgDir = wd
+
+	return r.unmarshalConfig()
+}
+
+func (r *FileReader) unmarshalConfig() error {
 	if err := viper.Unmarshal(r.cfg); err != nil {
 		return fmt.Errorf("can't unmarshal config by viper: %s", err)
 	}
 
+	for i := range r.cfg.Issues.ExcludeRules {
+		if err := r.cfg.Issues.ExcludeRules[i].ExpandEnv(); err != nil {
+			return fmt.Errorf("error in exclude rule #%d: %v", i, err)
+		}
+	}
+
 	if err := r.validateConfig(); err != nil {
 		return fmt.Errorf("can't validate config: %s", err)
 	}
@@ -120,22 +161,27 @@ func (r *FileReader) validateConfig() error {
 	if c.Run.IsVerbose {
 		return errors.New("can't set run.verbose option with config: only on command-line")
 	}
-	for i, rule := range c.Issues.ExcludeRules {
-		if err := rule.Validate(); err != nil {
-			return fmt.Errorf("error in exclude rule #%d: %v", i, err)
-		}
-	}
-	if len(c.Severity.Rules) > 0 && c.Severity.Default == "" {
-		return errors.New("can't set severity rule option: no default severity defined")
+	return c.Validate()
+}
+
+// validateCustomDefaultExcludes checks the custom default excludes,
+// their IDs must be unique, and different from the IDs of the built-in ones.
+func validateCustomDefaultExcludes(patterns []ExcludePattern) error {
+	ids := map[string]bool{}
+	for _, p := range DefaultExcludePatterns {
+		ids[p.ID] = true
 	}
-	for i, rule := range c.Severity.Rules {
-		if err := rule.Validate(); err != nil {
-			return fmt.Errorf("error in severity rule #%d: %v", i, err)
+
+	for i, p := range patterns {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("error in custom default exclude #%d: %v", i, err)
 		}
+		if ids[p.ID] {
+			return fmt.Errorf("error in custom default exclude #%d: duplicated id %q", i, p.ID)
+		}
+		ids[p.ID] = true
 	}
-	if err := c.LintersSettings.Govet.Validate(); err != nil {
-		return fmt.Errorf("error in govet config: %v", err)
-	}
+
 	return nil
 }
 
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// envConfigToken is the bearer token sent when fetching a remote config.
+const envConfigToken = "GOLANGCI_LINT_CONFIG_TOKEN"
+
+const remoteConfigTimeout = 10 * time.Second
+
+// maxRemoteConfigRedirects is the limit of the redirects of the default HTTP client.
+const maxRemoteConfigRedirects = 10
+
+// isRemoteConfig reports whether the --config option is an HTTP(S) URL.
+func isRemoteConfig(configFile string) bool {
+	return strings.HasPrefix(configFile, "http://") || strings.HasPrefix(configFile, "https://")
+}
+
+// fetchRemoteConfig downloads the config at the URL with the client,
+// with an `Authorization: Bearer` header if the token isn't empty.
+// The token is sent only over HTTPS: it isn't sent in clear, even after a redirect.
+func fetchRemoteConfig(client *http.Client, configURL, token string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, configURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if token != "" {
+		if req.URL.Scheme != "https" {
+			return nil, fmt.Errorf("the token of %s is only sent over https", envConfigToken)
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		withToken := *client
+		withToken.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" {
+				return fmt.Errorf("redirect to %s: the token of %s is only sent over https", req.URL, envConfigToken)
+			}
+			if len(via) >= maxRemoteConfigRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRemoteConfigRedirects)
+			}
+			return nil
+		}
+		client = &withToken
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can't read response body: %w", err)
+	}
+
+	return data, nil
+}
+
+// remoteConfigType returns the viper config type from the extension of the URL path, YAML if there is none.
+func remoteConfigType(configURL string) string {
+	u, err := url.Parse(configURL)
+	if err != nil {
+		return "yaml"
+	}
+
+	ext := strings.TrimPrefix(path.Ext(u.Path), ".")
+	if ext == "" {
+		return "yaml"
+	}
+
+	return ext
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRemoteConfig(t *testing.T) {
+	assert.True(t, isRemoteConfig("https://config.internal/golangci.yml"))
+	assert.True(t, isRemoteConfig("http://localhost:8080/golangci"))
+	assert.False(t, isRemoteConfig(".golangci.yml"))
+	assert.False(t, isRemoteConfig("/etc/golangci/https.yml"))
+	assert.False(t, isRemoteConfig(""))
+}
+
+func TestFetchRemoteConfig(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte("run:\n  timeout: 5m\n"))
+	}))
+	defer ts.Close()
+
+	data, err := fetchRemoteConfig(ts.Client(), ts.URL+"/golangci.yml", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, "run:\n  timeout: 5m\n", string(data))
+
+	_, err = fetchRemoteConfig(ts.Client(), ts.URL+"/golangci.yml", "")
+	assert.EqualError(t, err, "unexpected response status 401 Unauthorized")
+}
+
+func TestFetchRemoteConfigTokenOverHTTP(t *testing.T) {
+	var requested bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+		_, _ = w.Write([]byte("run:\n  timeout: 5m\n"))
+	}))
+	defer ts.Close()
+
+	_, err := fetchRemoteConfig(ts.Client(), ts.URL+"/golangci.yml", "secret")
+	assert.EqualError(t, err, "the token of GOLANGCI_LINT_CONFIG_TOKEN is only sent over https")
+	assert.False(t, requested)
+
+	// without token, the config can be fetched over http.
+	data, err := fetchRemoteConfig(ts.Client(), ts.URL+"/golangci.yml", "")
+	require.NoError(t, err)
+	assert.Equal(t, "run:\n  timeout: 5m\n", string(data))
+
+	// the token isn't sent in clear after a redirect either.
+	tlsServer := httptest.NewTLSServer(http.RedirectHandler(ts.URL+"/golangci.yml", http.StatusFound))
+	defer tlsServer.Close()
+
+	requested = false
+	_, err = fetchRemoteConfig(tlsServer.Client(), tlsServer.URL, "secret")
+	assert.ErrorContains(t, err, "the token of GOLANGCI_LINT_CONFIG_TOKEN is only sent over https")
+	assert.False(t, requested)
+}
+
+func TestFetchRemoteConfigTimeout(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	_, err := fetchRemoteConfig(&http.Client{Timeout: 50 * time.Millisecond}, ts.URL, "")
+	assert.Error(t, err)
+}
+
+func TestRemoteConfigType(t *testing.T) {
+	assert.Equal(t, "yml", remoteConfigType("https://config.internal/golangci.yml"))
+	assert.Equal(t, "toml", remoteConfigType("https://config.internal/golangci.toml?ref=main"))
+	assert.Equal(t, "yaml", remoteConfigType("https://config.internal/golangci"))
+}
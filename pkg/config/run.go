@@ -10,7 +10,16 @@ type Run struct {
 	MemProfilePath      string
 	TracePath           string
 	Concurrency         int
+	AnalyzerConcurrency int  `mapstructure:"analyzer-concurrency"`
 	PrintResourcesUsage bool `mapstructure:"print-resources-usage"`
+	Bench               bool
+	ProfileFiles        int
+	NoTiming            bool
+	Incremental         bool
+	PrintReproduce      bool
+	ShuffleLinters      string `mapstructure:"shuffle-linters"`
+
+	SourceReadConcurrency int `mapstructure:"source-read-concurrency"`
 
 	Config   string // The path to the golangci config file, as specified with the --config argument.
 	NoConfig bool
@@ -20,7 +29,9 @@ type Run struct {
 	Go string `mapstructure:"go"`
 
 	BuildTags           []string `mapstructure:"build-tags"`
+	GoVersions          []string `mapstructure:"go-versions"`
 	ModulesDownloadMode string   `mapstructure:"modules-download-mode"`
+	LoadMode            string   `mapstructure:"load-mode"`
 
 	ExitCodeIfIssuesFound int  `mapstructure:"issues-exit-code"`
 	AnalyzeTests          bool `mapstructure:"tests"`
@@ -34,6 +45,16 @@ type Run struct {
 	SkipFiles          []string `mapstructure:"skip-files"`
 	SkipDirs           []string `mapstructure:"skip-dirs"`
 	UseDefaultSkipDirs bool     `mapstructure:"skip-dirs-use-default"`
+	SkipVendor         bool     `mapstructure:"skip-vendor"`
+
+	OnlyTrackedFiles bool `mapstructure:"only-tracked-files"`
+
+	// GoFileExtensions are the extensions of the files considered as Go source files besides `.go`, e.g. `.go.tmpl`,
+	// by the skip dirs and generated files processors.
+	GoFileExtensions []string `mapstructure:"go-file-extensions"`
+
+	// DisableDefaultSkipDirs are the names of the default skipped directories to lint anyway.
+	DisableDefaultSkipDirs []string `mapstructure:"disable-default-skip-dirs"`
 
 	AllowParallelRunners bool `mapstructure:"allow-parallel-runners"`
 	AllowSerialRunners   bool `mapstructure:"allow-serial-runners"`
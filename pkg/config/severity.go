@@ -3,9 +3,13 @@ package config
 const severityRuleMinConditionsCount = 1
 
 type Severity struct {
-	Default       string         `mapstructure:"default-severity"`
-	CaseSensitive bool           `mapstructure:"case-sensitive"`
-	Rules         []SeverityRule `mapstructure:"rules"`
+	Default          string            `mapstructure:"default-severity"`
+	DefaultPerLinter map[string]string `mapstructure:"default-per-linter"`
+	CaseSensitive    bool              `mapstructure:"case-sensitive"`
+	Rules            []SeverityRule    `mapstructure:"rules"`
+
+	// CoverageProfile is the path of a Go coverage profile: the severity of the issues on the uncovered lines is raised.
+	CoverageProfile string `mapstructure:"coverage-profile"`
 }
 
 type SeverityRule struct {
@@ -33,6 +33,11 @@ func (fc *FileCache) GetFileBytes(filePath string) ([]byte, error) {
 	return fileBytes, nil
 }
 
+// Forget releases the cached bytes of the file: they are read again on the next access.
+func (fc *FileCache) Forget(filePath string) {
+	fc.files.Delete(filePath)
+}
+
 func PrettifyBytesCount(n int64) string {
 	const (
 		Multiplexer = 1024
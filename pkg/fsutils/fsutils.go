@@ -98,3 +98,30 @@ func ShortestRelPath(path, wd string) (string, error) {
 
 	return relPath, nil
 }
+
+// WriteFileAtomic writes the data to the file like os.WriteFile, through a temporary file of the same directory
+// renamed to the path: the readers of the file never see a partial content.
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+
+	tmpPath := f.Name()
+	defer os.Remove(tmpPath) // no-op once renamed
+
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Chmod(tmpPath, perm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
@@ -36,6 +36,47 @@ func (lc *LineCache) GetLine(filePath string, index1 int) (string, error) {
 	return string(bytes.Trim(rawLine, "\r")), nil
 }
 
+// GetLineOffset returns the byte offset in the file on filePath of the start of its index1-th (1-based index) line:
+// the line breaks, `\n` or `\r\n`, are counted.
+func (lc *LineCache) GetLineOffset(filePath string, index1 int) (int, error) {
+	fc, err := lc.getFileCache(filePath)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get file %s lines cache", filePath)
+	}
+
+	if index1 < 1 || index1 > len(fc) {
+		return 0, fmt.Errorf("invalid file line index1 (%d) out of [1, %d]", index1, len(fc))
+	}
+
+	offset := 0
+	for _, line := range fc[:index1-1] {
+		offset += len(line) + 1 // the \r of \r\n is kept at the end of the line
+	}
+
+	return offset, nil
+}
+
+// GetLinesCount returns the count of lines of the file on filePath
+func (lc *LineCache) GetLinesCount(filePath string) (int, error) {
+	fc, err := lc.getFileCache(filePath)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get file %s lines cache", filePath)
+	}
+
+	count := len(fc)
+	if count != 0 && len(fc[count-1]) == 0 {
+		count-- // the last line ends with a line break
+	}
+
+	return count, nil
+}
+
+// Forget releases the cached lines and bytes of the file: they are read again on the next access.
+func (lc *LineCache) Forget(filePath string) {
+	lc.files.Delete(filePath)
+	lc.fileCache.Forget(filePath)
+}
+
 func (lc *LineCache) getRawLine(filePath string, index0 int) ([]byte, error) {
 	fc, err := lc.getFileCache(filePath)
 	if err != nil {
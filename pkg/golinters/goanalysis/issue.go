@@ -24,8 +24,10 @@ type EncodingIssue struct {
 	FromLinter           string
 	Text                 string
 	Pos                  token.Position
+	EndPos               *result.EndPosition
 	LineRange            *result.Range
 	Replacement          *result.Replacement
 	ExpectNoLint         bool
 	ExpectedNoLintLinter string
+	CheckID              string
 }
@@ -2,10 +2,14 @@ package goanalysis
 
 import (
 	"fmt"
+	"go/token"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/result"
 )
 
 func TestParseError(t *testing.T) {
@@ -46,3 +50,21 @@ func TestParseError(t *testing.T) {
 		assert.Equal(t, "msg", i.Text)
 	}
 }
+
+func TestDiagnosticEndPos(t *testing.T) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("f.go", -1, 30)
+	file.SetLines([]int{0, 10, 20})
+
+	newDiag := func(pos, end token.Pos) *Diagnostic {
+		return &Diagnostic{
+			Diagnostic: analysis.Diagnostic{Pos: pos, End: end},
+			Position:   fset.Position(pos),
+			Pkg:        &packages.Package{Fset: fset},
+		}
+	}
+
+	assert.Equal(t, &result.EndPosition{Line: 3, Column: 6}, diagnosticEndPos(newDiag(file.Pos(4), file.Pos(25))))
+	assert.Nil(t, diagnosticEndPos(newDiag(file.Pos(4), token.NoPos)))
+	assert.Nil(t, diagnosticEndPos(newDiag(file.Pos(4), file.Pos(4))))
+}
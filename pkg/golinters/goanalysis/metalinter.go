@@ -57,6 +57,34 @@ func (ml MetaLinter) getAnalyzers() []*analysis.Analyzer {
 	return allAnalyzers
 }
 
+// LinterNames returns the names of the combined linters.
+func (ml MetaLinter) LinterNames() []string {
+	names := make([]string, 0, len(ml.linters))
+	for _, l := range ml.linters {
+		names = append(names, l.Name())
+	}
+	return names
+}
+
+// SyntaxOnly returns the metalinter of the combined linters needing only the syntax (nil if none),
+// and the names of the other ones.
+func (ml MetaLinter) SyntaxOnly() (syntaxOnly *MetaLinter, others []string) {
+	var linters []*Linter
+	for _, l := range ml.linters {
+		if l.loadMode > LoadModeSyntax {
+			others = append(others, l.Name())
+			continue
+		}
+		linters = append(linters, l)
+	}
+
+	if len(linters) == 0 {
+		return nil, others
+	}
+
+	return NewMetaLinter(linters), others
+}
+
 func (ml MetaLinter) getName() string {
 	return "metalinter"
 }
@@ -57,18 +57,22 @@ type runner struct {
 	passToPkg      map[*analysis.Pass]*packages.Package
 	passToPkgGuard sync.Mutex
 	sw             *timeutils.Stopwatch
+	fileTimings    *timeutils.FileTimings // times of the files of the initial packages, nil if not profiled
+	concurrency    int                    // the maximum number of packages analyzed in parallel, GOMAXPROCS if not positive
+	factsKeySuffix string                 // suffix of the cache keys of the facts, e.g. the hash of the linter settings
 }
 
 func newRunner(prefix string, logger logutils.Log, pkgCache *pkgcache.Cache, loadGuard *load.Guard,
-	loadMode LoadMode, sw *timeutils.Stopwatch) *runner {
+	loadMode LoadMode, sw *timeutils.Stopwatch, concurrency int) *runner {
 	return &runner{
-		prefix:    prefix,
-		log:       logger,
-		pkgCache:  pkgCache,
-		loadGuard: loadGuard,
-		loadMode:  loadMode,
-		passToPkg: map[*analysis.Pass]*packages.Package{},
-		sw:        sw,
+		prefix:      prefix,
+		log:         logger,
+		pkgCache:    pkgCache,
+		loadGuard:   loadGuard,
+		loadMode:    loadMode,
+		passToPkg:   map[*analysis.Pass]*packages.Package{},
+		sw:          sw,
+		concurrency: concurrency,
 	}
 }
 
@@ -261,9 +265,12 @@ func (r *runner) analyze(pkgs []*packages.Package, analyzers []*analysis.Analyze
 	}
 
 	// Limit memory and IO usage.
-	gomaxprocs := runtime.GOMAXPROCS(-1)
-	debugf("Analyzing at most %d packages in parallel", gomaxprocs)
-	loadSem := make(chan struct{}, gomaxprocs)
+	concurrency := r.concurrency
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(-1)
+	}
+	debugf("Analyzing at most %d packages in parallel", concurrency)
+	loadSem := make(chan struct{}, concurrency)
 
 	var wg sync.WaitGroup
 	debugf("There are %d initial and %d total packages", len(initialPkgs), len(loadingPackages))
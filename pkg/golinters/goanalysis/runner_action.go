@@ -116,6 +116,10 @@ func (act *action) analyze() {
 
 	defer func(now time.Time) {
 		analyzeDebugf("go/analysis: %s: %s: analyzed package %q in %s", act.r.prefix, act.a.Name, act.pkg.Name, time.Since(now))
+
+		if act.r.fileTimings != nil && act.isInitialPkg {
+			act.r.fileTimings.AddPackage(act.pkg.GoFiles, time.Since(now))
+		}
 	}(time.Now())
 
 	// Report an error if any dependency failures.
@@ -324,13 +328,13 @@ func (act *action) persistFactsToCache() error {
 
 	factsCacheDebugf("Caching %d facts for package %q and analyzer %s", len(facts), act.pkg.Name, act.a.Name)
 
-	key := fmt.Sprintf("%s/facts", analyzer.Name)
+	key := fmt.Sprintf("%s/facts%s", analyzer.Name, act.r.factsKeySuffix)
 	return act.r.pkgCache.Put(act.pkg, pkgcache.HashModeNeedAllDeps, key, facts)
 }
 
 func (act *action) loadPersistedFacts() bool {
 	var facts []Fact
-	key := fmt.Sprintf("%s/facts", act.a.Name)
+	key := fmt.Sprintf("%s/facts%s", act.a.Name, act.r.factsKeySuffix)
 	if err := act.r.pkgCache.Get(act.pkg, pkgcache.HashModeNeedAllDeps, key, &facts); err != nil {
 		if !errors.Is(err, pkgcache.ErrMissing) && !errors.Is(err, io.EOF) {
 			act.r.log.Warnf("Failed to get persisted facts: %s", err)
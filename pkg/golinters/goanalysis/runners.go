@@ -1,7 +1,9 @@
 package goanalysis
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"reflect"
 	"runtime"
 	"sort"
 	"strings"
@@ -11,8 +13,10 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/packages"
+	"gopkg.in/yaml.v3"
 
 	"github.com/golangci/golangci-lint/internal/pkgcache"
+	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
@@ -30,19 +34,38 @@ type runAnalyzersConfig interface {
 
 func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Issue, error) {
 	log := lintCtx.Log.Child(logutils.DebugKeyGoAnalysis)
-	sw := timeutils.NewStopwatch("analyzers", log)
+	swLog := log
+	if lintCtx.Cfg.Run.NoTiming {
+		swLog = logutils.NewDiscardLog()
+	}
+	sw := timeutils.NewStopwatch("analyzers", swLog)
 
 	const stagesToPrint = 10
 	defer sw.PrintTopStages(stagesToPrint)
 
-	runner := newRunner(cfg.getName(), log, lintCtx.PkgCache, lintCtx.LoadGuard, cfg.getLoadMode(), sw)
+	runner := newRunner(cfg.getName(), log, lintCtx.PkgCache, lintCtx.LoadGuard, cfg.getLoadMode(), sw,
+		lintCtx.Cfg.Run.AnalyzerConcurrency)
+	runner.fileTimings = lintCtx.FileTimings
+
+	lintResKey := getIssuesCacheKey(cfg.getAnalyzers())
+	if lintCtx.Cfg.Run.Incremental {
+		// the configuration salt of the cache doesn't cover the linters settings:
+		// the results and the facts of the linter are cached for its own settings only.
+		settingsHash, err := linterSettingsHash(&lintCtx.Cfg.LintersSettings, cfg.getName())
+		if err != nil {
+			return nil, err
+		}
+
+		lintResKey += "/" + settingsHash
+		runner.factsKeySuffix = "/" + settingsHash
+	}
 
 	pkgs := lintCtx.Packages
 	if cfg.useOriginalPackages() {
 		pkgs = lintCtx.OriginalPackages
 	}
 
-	issues, pkgsFromCache := loadIssuesFromCache(pkgs, lintCtx, cfg.getAnalyzers())
+	issues, pkgsFromCache := loadIssuesFromCache(pkgs, lintCtx, lintResKey)
 	var pkgsToAnalyze []*packages.Package
 	for _, pkg := range pkgs {
 		if !pkgsFromCache[pkg] {
@@ -56,7 +79,7 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 		if len(errs) == 0 {
 			// If we try to save to cache even if we have compilation errors
 			// we won't see them on repeated runs.
-			saveIssuesToCache(pkgs, pkgsFromCache, issues, lintCtx, cfg.getAnalyzers())
+			saveIssuesToCache(pkgs, pkgsFromCache, issues, lintCtx, lintResKey)
 		}
 	}()
 
@@ -91,17 +114,21 @@ func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) st
 		diag := &diags[i]
 		linterName := linterNameBuilder(diag)
 
-		var text string
+		// the analyzers of a linter with several ones are its checks, e.g. `SA1019` for staticcheck.
+		var text, checkID string
 		if diag.Analyzer.Name == linterName {
 			text = diag.Message
 		} else {
 			text = fmt.Sprintf("%s: %s", diag.Analyzer.Name, diag.Message)
+			checkID = diag.Analyzer.Name
 		}
 
 		issues = append(issues, result.Issue{
 			FromLinter: linterName,
 			Text:       text,
+			CheckID:    checkID,
 			Pos:        diag.Position,
+			EndPos:     diagnosticEndPos(diag),
 			Pkg:        diag.Pkg,
 		})
 
@@ -110,6 +137,7 @@ func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) st
 				issues = append(issues, result.Issue{
 					FromLinter: linterName,
 					Text:       fmt.Sprintf("%s(related information): %s", diag.Analyzer.Name, info.Message),
+					CheckID:    checkID,
 					Pos:        diag.Pkg.Fset.Position(info.Pos),
 					Pkg:        diag.Pkg,
 				})
@@ -119,12 +147,55 @@ func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) st
 	return issues
 }
 
+// diagnosticEndPos returns the end of the range of the diagnostic, if any.
+func diagnosticEndPos(diag *Diagnostic) *result.EndPosition {
+	if !diag.End.IsValid() || diag.End <= diag.Pos {
+		return nil
+	}
+
+	end := diag.Pkg.Fset.Position(diag.End)
+	if end.Filename != diag.Position.Filename {
+		return nil
+	}
+
+	return &result.EndPosition{Line: end.Line, Column: end.Column}
+}
+
 func getIssuesCacheKey(analyzers []*analysis.Analyzer) string {
 	return "lint/result:" + analyzersHashID(analyzers)
 }
 
+// linterSettingsHash returns the hash of the settings of the linter (`linters-settings.<name>`),
+// or of all the linters settings if the linter doesn't have its own: e.g. the metalinter.
+func linterSettingsHash(settings *config.LintersSettings, name string) (string, error) {
+	var value interface{} = settings
+
+	// like mapstructure: the keys are the tags of the fields, or their case-insensitive names.
+	rv := reflect.ValueOf(settings).Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+
+		key := field.Tag.Get("mapstructure")
+		if key == "" {
+			key = field.Name
+		}
+
+		if strings.EqualFold(key, name) {
+			value = rv.Field(i).Interface()
+			break
+		}
+	}
+
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the settings of %s: %w", name, err)
+	}
+
+	return fmt.Sprintf("settings:%x", sha256.Sum256(data)), nil
+}
+
 func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.Package]bool,
-	issues []result.Issue, lintCtx *linter.Context, analyzers []*analysis.Analyzer) {
+	issues []result.Issue, lintCtx *linter.Context, lintResKey string) {
 	startedAt := time.Now()
 	perPkgIssues := map[*packages.Package][]result.Issue{}
 	for ind := range issues {
@@ -133,7 +204,6 @@ func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.
 	}
 
 	savedIssuesCount := int32(0)
-	lintResKey := getIssuesCacheKey(analyzers)
 
 	workerCount := runtime.GOMAXPROCS(-1)
 	var wg sync.WaitGroup
@@ -152,10 +222,12 @@ func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.
 						FromLinter:           i.FromLinter,
 						Text:                 i.Text,
 						Pos:                  i.Pos,
+						EndPos:               i.EndPos,
 						LineRange:            i.LineRange,
 						Replacement:          i.Replacement,
 						ExpectNoLint:         i.ExpectNoLint,
 						ExpectedNoLintLinter: i.ExpectedNoLintLinter,
+						CheckID:              i.CheckID,
 					})
 				}
 
@@ -184,10 +256,9 @@ func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.
 
 //nolint:gocritic
 func loadIssuesFromCache(pkgs []*packages.Package, lintCtx *linter.Context,
-	analyzers []*analysis.Analyzer) ([]result.Issue, map[*packages.Package]bool) {
+	lintResKey string) ([]result.Issue, map[*packages.Package]bool) {
 	startedAt := time.Now()
 
-	lintResKey := getIssuesCacheKey(analyzers)
 	type cacheRes struct {
 		issues  []result.Issue
 		loadErr error
@@ -223,11 +294,13 @@ func loadIssuesFromCache(pkgs []*packages.Package, lintCtx *linter.Context,
 						FromLinter:           i.FromLinter,
 						Text:                 i.Text,
 						Pos:                  i.Pos,
+						EndPos:               i.EndPos,
 						LineRange:            i.LineRange,
 						Replacement:          i.Replacement,
 						Pkg:                  pkg,
 						ExpectNoLint:         i.ExpectNoLint,
 						ExpectedNoLintLinter: i.ExpectedNoLintLinter,
+						CheckID:              i.CheckID,
 					})
 				}
 				cacheRes.issues = issues
@@ -0,0 +1,36 @@
+package goanalysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+func TestLinterSettingsHash(t *testing.T) {
+	hashes := func(settings *config.LintersSettings) (errcheck, govet, metalinter string) {
+		t.Helper()
+
+		var err error
+		errcheck, err = linterSettingsHash(settings, "errcheck")
+		require.NoError(t, err)
+		govet, err = linterSettingsHash(settings, "govet")
+		require.NoError(t, err)
+		metalinter, err = linterSettingsHash(settings, "metalinter")
+		require.NoError(t, err)
+		return errcheck, govet, metalinter
+	}
+
+	var settings config.LintersSettings
+	errcheck, govet, metalinter := hashes(&settings)
+
+	settings.Errcheck.CheckTypeAssertions = true
+	newErrcheck, newGovet, newMetalinter := hashes(&settings)
+
+	assert.NotEqual(t, errcheck, newErrcheck)
+	assert.Equal(t, govet, newGovet)
+	// without its own settings, a linter depends on all the settings.
+	assert.NotEqual(t, metalinter, newMetalinter)
+}
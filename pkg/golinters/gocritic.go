@@ -76,11 +76,17 @@ Dynamic rules are written declaratively with AST patterns, filters, report messa
 		}).WithLoadMode(goanalysis.LoadModeTypesInfo)
 }
 
+// goCriticEmbeddedRulesOnce registers the embedded rules once per process:
+// the checkers are registered globally, and the linter can be created several times, e.g. to run again after --fix.
+var (
+	goCriticEmbeddedRulesOnce sync.Once
+	goCriticEmbeddedRulesErr  error
+)
+
 type goCriticWrapper struct {
 	settingsWrapper *goCriticSettingsWrapper
 	cfg             *config.Config
 	sizes           types.Sizes
-	once            sync.Once
 }
 
 func (w *goCriticWrapper) init(settings *config.GoCriticSettings, logger logutils.Log) {
@@ -88,12 +94,12 @@ func (w *goCriticWrapper) init(settings *config.GoCriticSettings, logger logutil
 		return
 	}
 
-	w.once.Do(func() {
-		err := checkers.InitEmbeddedRules()
-		if err != nil {
-			logger.Fatalf("%s: %v: setting an explicit GOROOT can fix this problem.", goCriticName, err)
-		}
+	goCriticEmbeddedRulesOnce.Do(func() {
+		goCriticEmbeddedRulesErr = checkers.InitEmbeddedRules()
 	})
+	if goCriticEmbeddedRulesErr != nil {
+		logger.Fatalf("%s: %v: setting an explicit GOROOT can fix this problem.", goCriticName, goCriticEmbeddedRulesErr)
+	}
 
 	settingsWrapper := newGoCriticSettingsWrapper(settings, logger)
 
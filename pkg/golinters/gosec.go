@@ -133,6 +133,7 @@ func runGoSec(lintCtx *linter.Context, pass *analysis.Pass, settings *config.GoS
 				Column:   column,
 			},
 			Text:       text,
+			CheckID:    i.RuleID,
 			LineRange:  r,
 			FromLinter: gosecName,
 		}, pass))
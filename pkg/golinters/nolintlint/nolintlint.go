@@ -137,7 +137,8 @@ const (
 var commentPattern = regexp.MustCompile(`^//\s*(nolint)(:\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*)?\b`)
 
 // matches a complete nolint directive
-var fullDirectivePattern = regexp.MustCompile(`^//\s*nolint(?::(\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*))?\s*(//.*)?\s*\n?$`)
+var fullDirectivePattern = regexp.MustCompile(
+	`^//\s*nolint(?::(\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*))?(?:except:(\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*))?\s*(//.*)?\s*\n?$`)
 
 type Linter struct {
 	needs           Needs // indicates which linter checks to perform
@@ -228,7 +229,13 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 					continue
 				}
 
-				lintersText, explanation := fullMatches[1], fullMatches[2]
+				lintersText, exceptText, explanation := fullMatches[1], fullMatches[2], fullMatches[3]
+
+				// the except clause is only allowed after `all`: `//nolint:all except:linter1,linter2`
+				if exceptText != "" && strings.TrimSpace(lintersText) != "all" {
+					issues = append(issues, ParseError{BaseIssue: base})
+					continue
+				}
 
 				var linters []string
 				if len(lintersText) > 0 && !strings.HasPrefix(lintersText, "all") {
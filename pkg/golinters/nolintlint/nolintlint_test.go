@@ -135,6 +135,20 @@ func foo() {
 				{issue: "directive `//nolint:linter1 linter2` should match `//nolint[:<comma-separated-linters>] [// <explanation>]` at testing.go:6:9"}, //nolint:lll // this is a string
 			},
 		},
+		{
+			desc: "except clause is allowed after all",
+			contents: `
+package bar
+
+func foo() {
+  good() //nolint:all except:linter1,linter2
+  good() //nolint:all except: linter1 // this is ok
+  bad() //nolint:linter1 except:linter2
+}`,
+			expected: []issueWithReplacement{
+				{issue: "directive `//nolint:linter1 except:linter2` should match `//nolint[:<comma-separated-linters>] [// <explanation>]` at testing.go:7:9"}, //nolint:lll // this is a string
+			},
+		},
 		{
 			desc: "multi-line comments don't confuse parser",
 			contents: `
@@ -141,15 +141,22 @@ func reviveToIssue(pass *analysis.Pass, object *jsonObject) goanalysis.Issue {
 		lineRangeTo = object.Position.Start.Line
 	}
 
+	var endPos *result.EndPosition
+	if object.Position.End.Line > 0 && object.Position.End != object.Position.Start {
+		endPos = &result.EndPosition{Line: object.Position.End.Line, Column: object.Position.End.Column}
+	}
+
 	return goanalysis.NewIssue(&result.Issue{
 		Severity: string(object.Severity),
 		Text:     fmt.Sprintf("%s: %s", object.RuleName, object.Failure.Failure),
+		CheckID:  object.RuleName,
 		Pos: token.Position{
 			Filename: object.Position.Start.Filename,
 			Line:     object.Position.Start.Line,
 			Offset:   object.Position.Start.Offset,
 			Column:   object.Position.Start.Column,
 		},
+		EndPos: endPos,
 		LineRange: &result.Range{
 			From: object.Position.Start.Line,
 			To:   lineRangeTo,
@@ -1,6 +1,7 @@
 package golinters
 
 import (
+	"regexp"
 	"strings"
 	"unicode"
 
@@ -46,6 +47,19 @@ func setupStaticCheckAnalyzers(src []*lint.Analyzer, goVersion string, checks []
 	return ret
 }
 
+// getNonDefaultAnalyzersExcludes returns the patterns of the issue texts of the analyzers disabled by default by staticcheck:
+// golangci-lint enables all the analyzers by default for compatibility reason.
+func getNonDefaultAnalyzersExcludes(src []*lint.Analyzer) []string {
+	var patterns []string
+	for _, a := range src {
+		if a.Doc != nil && a.Doc.NonDefault {
+			patterns = append(patterns, "^"+regexp.QuoteMeta(a.Analyzer.Name)+":")
+		}
+	}
+
+	return patterns
+}
+
 func setAnalyzerGoVersion(a *analysis.Analyzer, goVersion string) {
 	if v := a.Flags.Lookup("go"); v != nil {
 		if err := v.Value.Set(goVersion); err != nil {
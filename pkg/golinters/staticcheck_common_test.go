@@ -0,0 +1,15 @@
+package golinters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStylecheckRecommendedExcludes(t *testing.T) {
+	excludes := StylecheckRecommendedExcludes()
+
+	assert.Contains(t, excludes, "^ST1000:")
+	assert.Contains(t, excludes, "^ST1003:")
+	assert.NotContains(t, excludes, "^ST1005:") // enabled by default
+}
@@ -9,6 +9,12 @@ import (
 	"github.com/golangci/golangci-lint/pkg/golinters/goanalysis"
 )
 
+// StylecheckRecommendedExcludes returns the patterns excluding the issues of the checks
+// that aren't enabled by default by staticcheck (e.g. ST1000).
+func StylecheckRecommendedExcludes() []string {
+	return getNonDefaultAnalyzersExcludes(stylecheck.Analyzers)
+}
+
 func NewStylecheck(settings *config.StaticCheckSettings) *goanalysis.Linter {
 	cfg := staticCheckConfig(settings)
 
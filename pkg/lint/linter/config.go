@@ -48,6 +48,10 @@ type Config struct {
 
 	Since       string
 	Deprecation *Deprecation
+
+	// RecommendedExcludes are the regexps of the issue texts the linter itself recommends excluding,
+	// applied only with `issues.use-linter-recommended-excludes`.
+	RecommendedExcludes []string
 }
 
 func (lc *Config) ConsiderSlow() *Config {
@@ -59,14 +63,20 @@ func (lc *Config) IsSlowLinter() bool {
 	return lc.IsSlow
 }
 
+// LoadModeFiles is the load mode of the linters needing only the files of the packages, e.g. parsing them.
+const LoadModeFiles = packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles
+
+// LoadModeGoAnalysis is the load mode of the linters needing the types and the dependencies of the packages.
+const LoadModeGoAnalysis = LoadModeFiles |
+	packages.NeedImports | packages.NeedDeps | packages.NeedExportFile | packages.NeedTypesSizes
+
 func (lc *Config) WithLoadFiles() *Config {
-	lc.LoadMode |= packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles
+	lc.LoadMode |= LoadModeFiles
 	return lc
 }
 
 func (lc *Config) WithLoadForGoAnalysis() *Config {
-	lc = lc.WithLoadFiles()
-	lc.LoadMode |= packages.NeedImports | packages.NeedDeps | packages.NeedExportFile | packages.NeedTypesSizes
+	lc.LoadMode |= LoadModeGoAnalysis
 	lc.IsSlow = true
 	return lc
 }
@@ -101,6 +111,11 @@ func (lc *Config) WithSince(version string) *Config {
 	return lc
 }
 
+func (lc *Config) WithRecommendedExcludes(patterns ...string) *Config {
+	lc.RecommendedExcludes = patterns
+	return lc
+}
+
 func (lc *Config) Deprecated(message, version, replacement string) *Config {
 	lc.Deprecation = &Deprecation{
 		Since:       version,
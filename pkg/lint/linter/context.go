@@ -10,6 +10,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/golinters/goanalysis/load"
 	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/timeutils"
 )
 
 type Context struct {
@@ -27,6 +28,13 @@ type Context struct {
 
 	PkgCache  *pkgcache.Cache
 	LoadGuard *load.Guard
+
+	// LooseFiles is set when the packages are ad-hoc packages of files given as arguments
+	// which aren't a loadable package: they have no type info, only the syntax-level linters can run.
+	LooseFiles bool
+
+	// FileTimings collects the analysis times of the files for --profile-files, nil if not profiled.
+	FileTimings *timeutils.FileTimings
 }
 
 func (c *Context) Settings() *config.LintersSettings {
@@ -4,6 +4,8 @@ import (
 	"os"
 	"sort"
 
+	"golang.org/x/tools/go/packages"
+
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/golinters/goanalysis"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
@@ -33,6 +35,12 @@ func NewEnabledSet(m *Manager, v *Validator, log logutils.Log, cfg *config.Confi
 
 func (es EnabledSet) build(lcfg *config.Linters, enabledByDefaultLinters []*linter.Config) map[string]*linter.Config {
 	es.debugf("Linters config: %#v", lcfg)
+
+	// --only overrides the enabled/disabled linters resolution: the validator ensures it's exactly one linter.
+	if lcfg.Only != "" {
+		return linterConfigsToMap(es.m.GetLinterConfigs(lcfg.Only))
+	}
+
 	resultLintersSet := map[string]*linter.Config{}
 	switch {
 	case len(lcfg.Presets) != 0:
@@ -134,6 +142,8 @@ func (es EnabledSet) GetOptimizedLinters() ([]*linter.Config, error) {
 func (es EnabledSet) combineGoAnalysisLinters(linters map[string]*linter.Config) {
 	var goanalysisLinters []*goanalysis.Linter
 	goanalysisPresets := map[string]bool{}
+	var loadMode packages.LoadMode
+	var isSlow bool
 	for _, linter := range linters {
 		lnt, ok := linter.Linter.(*goanalysis.Linter)
 		if !ok {
@@ -144,6 +154,8 @@ func (es EnabledSet) combineGoAnalysisLinters(linters map[string]*linter.Config)
 			continue
 		}
 		goanalysisLinters = append(goanalysisLinters, lnt)
+		loadMode |= linter.LoadMode
+		isSlow = isSlow || linter.IsSlow
 		for _, p := range linter.InPresets {
 			goanalysisPresets[p] = true
 		}
@@ -154,6 +166,12 @@ func (es EnabledSet) combineGoAnalysisLinters(linters map[string]*linter.Config)
 		return
 	}
 
+	if es.cfg.Run.Incremental {
+		// the results of the linters are cached separately: a change of the settings of a linter runs only this linter.
+		es.debugf("Didn't combine go/analysis linters: the incremental mode caches the results of each linter")
+		return
+	}
+
 	for _, lnt := range goanalysisLinters {
 		delete(linters, lnt.Name())
 	}
@@ -186,10 +204,11 @@ func (es EnabledSet) combineGoAnalysisLinters(linters map[string]*linter.Config)
 		InPresets:        presets,
 		AlternativeNames: nil,
 		OriginalURL:      "",
+		// the metalinter loads only what its linters need, e.g. only the files for the formatters.
+		LoadMode: loadMode,
+		IsSlow:   isSlow,
 	}
 
-	mlConfig = mlConfig.WithLoadForGoAnalysis()
-
 	linters[ml.Name()] = mlConfig
 	es.debugf("Combined %d go/analysis linters into one metalinter", len(goanalysisLinters))
 }
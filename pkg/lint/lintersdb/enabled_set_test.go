@@ -89,6 +89,16 @@ func TestGetEnabledLintersSet(t *testing.T) {
 			},
 			def: []string{"gosec"},
 		},
+		{
+			name: "only one linter",
+			cfg: config.Linters{
+				Only:      "gas",
+				Enable:    []string{"govet"},
+				EnableAll: true,
+			},
+			def: []string{"gofmt"},
+			exp: []string{"gosec"},
+		},
 	}
 
 	m := NewManager(nil, nil)
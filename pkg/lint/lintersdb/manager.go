@@ -746,7 +746,8 @@ func (m Manager) GetAllSupportedLinterConfigs() []*linter.Config {
 			WithSince("v1.20.0").
 			WithLoadForGoAnalysis().
 			WithPresets(linter.PresetStyle).
-			WithURL("https://github.com/dominikh/go-tools/tree/master/stylecheck"),
+			WithURL("https://github.com/dominikh/go-tools/tree/master/stylecheck").
+			WithRecommendedExcludes(golinters.StylecheckRecommendedExcludes()...),
 
 		linter.NewConfig(golinters.NewTagliatelle(tagliatelleCfg)).
 			WithSince("v1.40.0").
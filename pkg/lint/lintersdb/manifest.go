@@ -0,0 +1,102 @@
+package lintersdb
+
+import (
+	"reflect"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+)
+
+// Manifest is the resolved set of the linters, to compare the linters configurations between environments.
+type Manifest struct {
+	Version string           `json:"version"`
+	Linters []ManifestLinter `json:"linters"` // sorted by name
+}
+
+type ManifestLinter struct {
+	Name             string `json:"name"`
+	Enabled          bool   `json:"enabled"`
+	EnabledByDefault bool   `json:"enabledByDefault"`
+
+	// Module and ModuleVersion are the Go module of the linter source, as built in the binary.
+	Module        string `json:"module,omitempty"`
+	ModuleVersion string `json:"moduleVersion,omitempty"`
+
+	Settings interface{} `json:"settings,omitempty"`
+}
+
+// GetManifest returns the manifest of all the supported linters.
+// The build info is used to find the source modules versions: it can be nil.
+func (m Manager) GetManifest(version string, enabledLinters map[string]*linter.Config, info *debug.BuildInfo) Manifest {
+	manifest := Manifest{Version: version}
+
+	for _, lc := range m.GetAllSupportedLinterConfigs() {
+		ml := ManifestLinter{
+			Name:             lc.Name(),
+			Enabled:          enabledLinters[lc.Name()] != nil,
+			EnabledByDefault: lc.EnabledByDefault,
+		}
+
+		ml.Module, ml.ModuleVersion = findLinterModule(lc.OriginalURL, info)
+
+		if m.cfg != nil {
+			ml.Settings = findLinterSettings(&m.cfg.LintersSettings, lc.Name())
+		}
+
+		manifest.Linters = append(manifest.Linters, ml)
+	}
+
+	sort.Slice(manifest.Linters, func(i, j int) bool {
+		return manifest.Linters[i].Name < manifest.Linters[j].Name
+	})
+
+	return manifest
+}
+
+// findLinterModule returns the module (and its version) the most specific to the URL of the linter source.
+func findLinterModule(url string, info *debug.BuildInfo) (path, version string) {
+	if info == nil || url == "" {
+		return "", ""
+	}
+
+	repo := strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(url, "https://"), "http://"), "/")
+
+	modules := append([]*debug.Module{&info.Main}, info.Deps...)
+	for _, mod := range modules {
+		if mod == nil || len(mod.Path) <= len(path) {
+			continue
+		}
+
+		// the module can be a sub-directory of the repository, or a major version of it.
+		if mod.Path == repo || strings.HasPrefix(repo, mod.Path+"/") || strings.HasPrefix(mod.Path, repo+"/") {
+			path, version = mod.Path, mod.Version
+			if mod.Replace != nil {
+				version = mod.Replace.Version
+			}
+		}
+	}
+
+	return path, version
+}
+
+// findLinterSettings returns the field of the settings matching the linter name, nil if it has no settings.
+func findLinterSettings(settings *config.LintersSettings, linterName string) interface{} {
+	normalize := func(name string) string {
+		return strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(name))
+	}
+
+	name := normalize(linterName)
+
+	v := reflect.ValueOf(settings).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if normalize(field.Name) == name || normalize(field.Tag.Get("mapstructure")) == name {
+			return v.Field(i).Interface()
+		}
+	}
+
+	return nil
+}
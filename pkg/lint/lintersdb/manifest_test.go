@@ -0,0 +1,94 @@
+package lintersdb
+
+import (
+	"runtime/debug"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+)
+
+func TestFindLinterModule(t *testing.T) {
+	info := &debug.BuildInfo{
+		Main: debug.Module{Path: "github.com/golangci/golangci-lint", Version: "(devel)"},
+		Deps: []*debug.Module{
+			{Path: "github.com/kisielk/errcheck", Version: "v1.6.2"},
+			{Path: "github.com/go-critic/go-critic", Version: "v0.6.5"},
+			{Path: "github.com/ashanbrown/forbidigo", Version: "v1.3.0",
+				Replace: &debug.Module{Path: "github.com/fork/forbidigo", Version: "v1.3.1"}},
+			{Path: "github.com/tomarrell/wrapcheck/v2", Version: "v2.7.0"},
+		},
+	}
+
+	testCases := []struct {
+		url     string
+		path    string
+		version string
+	}{
+		{url: "https://github.com/kisielk/errcheck", path: "github.com/kisielk/errcheck", version: "v1.6.2"},
+		{url: "https://github.com/go-critic/go-critic/", path: "github.com/go-critic/go-critic", version: "v0.6.5"},
+		{url: "https://github.com/ashanbrown/forbidigo", path: "github.com/ashanbrown/forbidigo", version: "v1.3.1"},
+		{url: "https://github.com/tomarrell/wrapcheck", path: "github.com/tomarrell/wrapcheck/v2", version: "v2.7.0"},
+		{url: "https://github.com/golangci/golangci-lint", path: "github.com/golangci/golangci-lint", version: "(devel)"},
+		{url: "https://golang.org/cmd/gofmt/"},
+		{url: ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.url, func(t *testing.T) {
+			path, version := findLinterModule(tc.url, info)
+			assert.Equal(t, tc.path, path)
+			assert.Equal(t, tc.version, version)
+		})
+	}
+
+	path, version := findLinterModule("https://github.com/kisielk/errcheck", nil)
+	assert.Empty(t, path)
+	assert.Empty(t, version)
+}
+
+func TestFindLinterSettings(t *testing.T) {
+	settings := config.LintersSettings{
+		Gocyclo:  config.GoCycloSettings{MinComplexity: 10},
+		Goheader: config.GoHeaderSettings{Template: "foo"},
+	}
+
+	assert.Equal(t, config.GoCycloSettings{MinComplexity: 10}, findLinterSettings(&settings, "gocyclo"))
+	assert.Equal(t, config.GoHeaderSettings{Template: "foo"}, findLinterSettings(&settings, "go-header"))
+	assert.Nil(t, findLinterSettings(&settings, "ineffassign"))
+}
+
+func TestManager_GetManifest(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.LintersSettings.Gocyclo.MinComplexity = 10
+
+	m := NewManager(cfg, nil)
+
+	enabled := map[string]*linter.Config{}
+	for _, lc := range m.GetLinterConfigs("gocyclo") {
+		enabled[lc.Name()] = lc
+	}
+
+	manifest := m.GetManifest("1.2.3", enabled, nil)
+	assert.Equal(t, "1.2.3", manifest.Version)
+	require.Len(t, manifest.Linters, len(m.GetAllSupportedLinterConfigs()))
+
+	assert.True(t, sort.SliceIsSorted(manifest.Linters, func(i, j int) bool {
+		return manifest.Linters[i].Name < manifest.Linters[j].Name
+	}))
+
+	for _, ml := range manifest.Linters {
+		if ml.Name != "gocyclo" {
+			assert.False(t, ml.Enabled, ml.Name)
+			continue
+		}
+
+		assert.True(t, ml.Enabled)
+		assert.Equal(t, config.GoCycloSettings{MinComplexity: 10}, ml.Settings)
+	}
+}
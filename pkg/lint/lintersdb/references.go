@@ -0,0 +1,185 @@
+package lintersdb
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+// UnknownLinterReference is a linter name of the configuration which is neither a linter nor an alias.
+type UnknownLinterReference struct {
+	Name string
+	// Option is the option referencing the name, e.g. `issues.exclude-rules[2].linters`.
+	Option string
+	// Suggestion is the closest known name, if any.
+	Suggestion string
+}
+
+func (r UnknownLinterReference) String() string {
+	if r.Suggestion == "" {
+		return fmt.Sprintf("%s: unknown linter %q", r.Option, r.Name)
+	}
+	return fmt.Sprintf("%s: unknown linter %q, did you mean %q?", r.Option, r.Name, r.Suggestion)
+}
+
+// FindUnknownLinterReferences returns the linter names of the configuration which aren't known by the manager,
+// in the order of the options.
+func (v Validator) FindUnknownLinterReferences(cfg *config.Config) []UnknownLinterReference {
+	var refs []UnknownLinterReference
+	check := func(option string, names ...string) {
+		for _, name := range names {
+			if v.m.GetLinterConfigs(name) != nil {
+				continue
+			}
+
+			refs = append(refs, UnknownLinterReference{
+				Name:       name,
+				Option:     option,
+				Suggestion: v.suggestLinterName(name),
+			})
+		}
+	}
+
+	check("linters.enable", cfg.Linters.Enable...)
+	check("linters.disable", cfg.Linters.Disable...)
+	if cfg.Linters.Only != "" {
+		check("linters.only", cfg.Linters.Only)
+	}
+
+	for i := range cfg.Issues.ExcludeRules {
+		check(fmt.Sprintf("issues.exclude-rules[%d].linters", i), cfg.Issues.ExcludeRules[i].Linters...)
+	}
+	var weighted []string
+	for name := range cfg.Issues.LinterWeights {
+		weighted = append(weighted, name)
+	}
+	sort.Strings(weighted)
+	check("issues.linter-weights", weighted...)
+	check("issues.merge-range-linters", cfg.Issues.MergeRangeLinters...)
+	check("issues.generated-files-linters", cfg.Issues.GeneratedFilesLinters...)
+	var withMinColumn []string
+	for name := range cfg.Issues.MinColumnPerLinter {
+		withMinColumn = append(withMinColumn, name)
+	}
+	sort.Strings(withMinColumn)
+	check("issues.min-column-per-linter", withMinColumn...)
+
+	for i := range cfg.Severity.Rules {
+		check(fmt.Sprintf("severity.rules[%d].linters", i), cfg.Severity.Rules[i].Linters...)
+	}
+	var withDefaultSeverity []string
+	for name := range cfg.Severity.DefaultPerLinter {
+		withDefaultSeverity = append(withDefaultSeverity, name)
+	}
+	sort.Strings(withDefaultSeverity)
+	check("severity.default-per-linter", withDefaultSeverity...)
+
+	return refs
+}
+
+// FindUnknownLintersSettings returns the keys of linters-settings, as read from the config file, which aren't
+// the settings of a linter, sorted: the decoding of the config silently ignores them.
+func FindUnknownLintersSettings(keys []string) []UnknownLinterReference {
+	known := lintersSettingsKeys()
+
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+
+	var refs []UnknownLinterReference
+	for _, key := range sorted {
+		if known[key] {
+			continue
+		}
+
+		refs = append(refs, UnknownLinterReference{
+			Name:       key,
+			Option:     "linters-settings",
+			Suggestion: suggestName(key, known),
+		})
+	}
+
+	return refs
+}
+
+// lintersSettingsKeys returns the keys of linters-settings decoded into config.LintersSettings:
+// the fields are matched by their lowercase names, as the keys read by viper.
+func lintersSettingsKeys() map[string]bool {
+	t := reflect.TypeOf(config.LintersSettings{})
+
+	keys := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		key := strings.ToLower(t.Field(i).Name)
+		if tag := t.Field(i).Tag.Get("mapstructure"); tag != "" {
+			key = tag
+		}
+		keys[key] = true
+	}
+
+	return keys
+}
+
+// suggestLinterName returns the known name closest to the unknown one, or an empty string if none is close enough.
+func (v Validator) suggestLinterName(name string) string {
+	known := make(map[string]bool, len(v.m.nameToLCs))
+	for n := range v.m.nameToLCs {
+		known[n] = true
+	}
+
+	return suggestName(name, known)
+}
+
+// suggestName returns the known name closest to the unknown one, or an empty string if none is close enough.
+func suggestName(name string, known map[string]bool) string {
+	// a typo is at most 2 edits, less for the short names.
+	maxDistance := 2
+	if len(name) <= 4 {
+		maxDistance = 1
+	}
+
+	suggestion, suggestionDistance := "", maxDistance+1
+	for candidate := range known {
+		distance := levenshteinDistance(name, candidate)
+
+		// the ties are broken by name, for a stable suggestion.
+		if distance < suggestionDistance || (distance == suggestionDistance && candidate < suggestion) {
+			suggestion, suggestionDistance = candidate, distance
+		}
+	}
+
+	return suggestion
+}
+
+func levenshteinDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+
+	return prev[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
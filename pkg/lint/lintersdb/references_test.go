@@ -0,0 +1,72 @@
+package lintersdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+func TestFindUnknownLinterReferences(t *testing.T) {
+	v := NewValidator(NewManager(nil, nil))
+
+	cfg := &config.Config{
+		Linters: config.Linters{
+			Enable:  []string{"govet", "gas", "errchek"},
+			Disable: []string{"unknownlinter"},
+		},
+		Issues: config.Issues{
+			ExcludeRules: []config.ExcludeRule{
+				{BaseRule: config.BaseRule{Linters: []string{"typecheck"}}},
+				{BaseRule: config.BaseRule{Linters: []string{"lll", "golintt"}}},
+			},
+			LinterWeights: map[string]int{"gosec": 5, "gosecc": 3},
+		},
+		Severity: config.Severity{
+			Rules: []config.SeverityRule{
+				{BaseRule: config.BaseRule{Linters: []string{"dupll"}}},
+			},
+			DefaultPerLinter: map[string]string{"gofmt": "warning", "gofmtt": "info"},
+		},
+	}
+
+	expected := []UnknownLinterReference{
+		{Name: "errchek", Option: "linters.enable", Suggestion: "errcheck"},
+		{Name: "unknownlinter", Option: "linters.disable"},
+		{Name: "golintt", Option: "issues.exclude-rules[1].linters", Suggestion: "golint"},
+		{Name: "gosecc", Option: "issues.linter-weights", Suggestion: "gosec"},
+		{Name: "dupll", Option: "severity.rules[0].linters", Suggestion: "dupl"},
+		{Name: "gofmtt", Option: "severity.default-per-linter", Suggestion: "gofmt"},
+	}
+	assert.Equal(t, expected, v.FindUnknownLinterReferences(cfg))
+}
+
+func TestFindUnknownLinterReferencesNone(t *testing.T) {
+	v := NewValidator(NewManager(nil, nil))
+
+	cfg := &config.Config{Linters: config.Linters{Enable: []string{"govet", "megacheck"}, Only: "gosec"}}
+	assert.Empty(t, v.FindUnknownLinterReferences(cfg))
+}
+
+func TestFindUnknownLintersSettings(t *testing.T) {
+	expected := []UnknownLinterReference{
+		{Name: "errchek", Option: "linters-settings", Suggestion: "errcheck"},
+		{Name: "unknownlinter", Option: "linters-settings"},
+	}
+	assert.Equal(t, expected, FindUnknownLintersSettings([]string{"unknownlinter", "govet", "errchek", "custom", "errchkjson"}))
+}
+
+func TestUnknownLinterReference_String(t *testing.T) {
+	assert.Equal(t, `linters.enable: unknown linter "errchek", did you mean "errcheck"?`,
+		UnknownLinterReference{Name: "errchek", Option: "linters.enable", Suggestion: "errcheck"}.String())
+	assert.Equal(t, `linters.disable: unknown linter "foo"`,
+		UnknownLinterReference{Name: "foo", Option: "linters.disable"}.String())
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	assert.Equal(t, 0, levenshteinDistance("gofmt", "gofmt"))
+	assert.Equal(t, 1, levenshteinDistance("errchek", "errcheck"))
+	assert.Equal(t, 2, levenshteinDistance("govte", "govet"))
+	assert.Equal(t, 5, levenshteinDistance("", "gofmt"))
+}
@@ -18,6 +18,24 @@ func NewValidator(m *Manager) *Validator {
 	}
 }
 
+func (v Validator) validateOnlyLinter(cfg *config.Linters) error {
+	if cfg.Only == "" {
+		return nil
+	}
+
+	lcs := v.m.GetLinterConfigs(cfg.Only)
+	if len(lcs) == 0 {
+		return fmt.Errorf("unknown linter '%s', run 'golangci-lint help linters' to see the list of supported linters",
+			cfg.Only)
+	}
+
+	if len(lcs) > 1 {
+		return fmt.Errorf("option --only requires exactly one linter: '%s' is an alias of %d linters", cfg.Only, len(lcs))
+	}
+
+	return nil
+}
+
 func (v Validator) validateLintersNames(cfg *config.Linters) error {
 	allNames := append([]string{}, cfg.Enable...)
 	allNames = append(allNames, cfg.Disable...)
@@ -93,6 +111,7 @@ func (v Validator) validateDisabledAndEnabledAtOneMoment(cfg *config.Linters) er
 
 func (v Validator) validateEnabledDisabledLintersConfig(cfg *config.Linters) error {
 	validators := []func(cfg *config.Linters) error{
+		v.validateOnlyLinter,
 		v.validateLintersNames,
 		v.validatePresets,
 		v.validateAllDisableEnableOptions,
@@ -0,0 +1,20 @@
+package lintersdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+func TestValidateOnlyLinter(t *testing.T) {
+	m := NewManager(nil, nil)
+	v := NewValidator(m)
+
+	assert.NoError(t, v.validateOnlyLinter(&config.Linters{}))
+	assert.NoError(t, v.validateOnlyLinter(&config.Linters{Only: "gosec"}))
+	assert.NoError(t, v.validateOnlyLinter(&config.Linters{Only: "gas"}))
+	assert.Error(t, v.validateOnlyLinter(&config.Linters{Only: "unknown"}))
+	assert.Error(t, v.validateOnlyLinter(&config.Linters{Only: "megacheck"}))
+}
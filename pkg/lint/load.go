@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"go/build"
+	"go/parser"
 	"go/token"
 	"os"
 	"path/filepath"
@@ -18,6 +19,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/golinters/goanalysis"
 	"github.com/golangci/golangci-lint/pkg/golinters/goanalysis/load"
 	"github.com/golangci/golangci-lint/pkg/goutil"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
@@ -64,13 +66,60 @@ func (cl *ContextLoader) prepareBuildContext() {
 	build.Default.BuildTags = cl.cfg.Run.BuildTags
 }
 
-func (cl *ContextLoader) findLoadMode(linters []*linter.Config) packages.LoadMode {
-	loadMode := packages.LoadMode(0)
+// The values of run.load-mode.
+const (
+	LoadModeAuto   = "auto"   // the mode needed by the enabled linters
+	LoadModeSyntax = "syntax" // only the files of the packages
+	LoadModeFull   = "full"   // the files, the types and the dependencies of the packages, the default
+)
+
+var forcedLoadModes = map[string]packages.LoadMode{
+	LoadModeSyntax: linter.LoadModeFiles,
+	LoadModeFull:   linter.LoadModeGoAnalysis,
+}
+
+func (cl *ContextLoader) findLoadMode(linters []*linter.Config) (packages.LoadMode, error) {
+	// the module is needed to set the module path of the issues.
+	loadMode := packages.NeedModule
 	for _, lc := range linters {
 		loadMode |= lc.LoadMode
 	}
 
-	return loadMode
+	mode := cl.cfg.Run.LoadMode
+	if mode == "" {
+		mode = LoadModeFull
+	}
+
+	if mode == LoadModeAuto {
+		return loadMode, nil
+	}
+
+	forced, ok := forcedLoadModes[mode]
+	if !ok {
+		return 0, fmt.Errorf("invalid load mode %s, only (%s|%s|%s) allowed",
+			mode, LoadModeAuto, LoadModeSyntax, LoadModeFull)
+	}
+	forced |= packages.NeedModule
+
+	// a lower mode than needed would break the linters.
+	var tooLow []string
+	for _, lc := range linters {
+		if lc.LoadMode&^forced == 0 {
+			continue
+		}
+
+		if ml, ok := lc.Linter.(*goanalysis.MetaLinter); ok {
+			tooLow = append(tooLow, ml.LinterNames()...)
+		} else {
+			tooLow = append(tooLow, lc.Name())
+		}
+	}
+	if len(tooLow) != 0 {
+		return 0, fmt.Errorf("load mode %s doesn't load what the linters %s need: use %s",
+			mode, strings.Join(tooLow, ", "), LoadModeFull)
+	}
+
+	return forced, nil
 }
 
 func (cl *ContextLoader) buildArgs() []string {
@@ -92,13 +141,13 @@ func (cl *ContextLoader) buildArgs() []string {
 	return retArgs
 }
 
-func (cl *ContextLoader) makeBuildFlags() ([]string, error) {
+func (cl *ContextLoader) makeBuildFlags(buildTags []string) ([]string, error) {
 	var buildFlags []string
 
-	if len(cl.cfg.Run.BuildTags) != 0 {
+	if len(buildTags) != 0 {
 		// go help build
-		buildFlags = append(buildFlags, "-tags", strings.Join(cl.cfg.Run.BuildTags, " "))
-		cl.log.Infof("Using build tags: %v", cl.cfg.Run.BuildTags)
+		buildFlags = append(buildFlags, "-tags", strings.Join(buildTags, " "))
+		cl.log.Infof("Using build tags: %v", buildTags)
 	}
 
 	mod := cl.cfg.Run.ModulesDownloadMode
@@ -129,6 +178,7 @@ func stringifyLoadMode(mode packages.LoadMode) string {
 		packages.NeedExportFile:      "exports_file",
 		packages.NeedFiles:           "files",
 		packages.NeedImports:         "imports",
+		packages.NeedModule:          "module",
 		packages.NeedName:            "name",
 		packages.NeedSyntax:          "syntax",
 		packages.NeedTypes:           "types",
@@ -174,14 +224,15 @@ func (cl *ContextLoader) parseLoadedPackagesErrors(pkgs []*packages.Package) err
 	return nil
 }
 
-func (cl *ContextLoader) loadPackages(ctx context.Context, loadMode packages.LoadMode) ([]*packages.Package, error) {
+func (cl *ContextLoader) loadPackages(ctx context.Context, loadMode packages.LoadMode,
+	buildTags []string) ([]*packages.Package, error) {
 	defer func(startedAt time.Time) {
 		cl.log.Infof("Go packages loading at mode %s took %s", stringifyLoadMode(loadMode), time.Since(startedAt))
 	}(time.Now())
 
 	cl.prepareBuildContext()
 
-	buildFlags, err := cl.makeBuildFlags()
+	buildFlags, err := cl.makeBuildFlags(buildTags)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to make build flags for go list")
 	}
@@ -284,12 +335,79 @@ func (cl *ContextLoader) filterDuplicatePackages(pkgs []*packages.Package) []*pa
 }
 
 func (cl *ContextLoader) Load(ctx context.Context, linters []*linter.Config) (*linter.Context, error) {
-	loadMode := cl.findLoadMode(linters)
-	pkgs, err := cl.loadPackages(ctx, loadMode)
+	return cl.load(ctx, linters, cl.cfg.Run.BuildTags)
+}
+
+// LoadGoVersions loads the packages once per Go version of the run.go-versions option,
+// with the version build tag (e.g. go1.21) added to the build tags:
+// the files gated by a version newer than the Go toolchain are loaded by the pass of this version.
+// The release tags of the toolchain can't be unset: the files excluded by an older version
+// (e.g. `//go:build !go1.21` with Go 1.21 or newer) are loaded by none of the passes.
+// Without Go versions, the packages are loaded once.
+func (cl *ContextLoader) LoadGoVersions(ctx context.Context, linters []*linter.Config) ([]*linter.Context, error) {
+	if len(cl.cfg.Run.GoVersions) == 0 {
+		lintCtx, err := cl.Load(ctx, linters)
+		if err != nil {
+			return nil, err
+		}
+		return []*linter.Context{lintCtx}, nil
+	}
+
+	var lintCtxs []*linter.Context
+	for _, version := range cl.cfg.Run.GoVersions {
+		versionTag, err := goVersionBuildTag(version)
+		if err != nil {
+			return nil, err
+		}
+
+		buildTags := append([]string{versionTag}, cl.cfg.Run.BuildTags...)
+
+		cl.log.Infof("Loading packages for Go version %s", version)
+		lintCtx, err := cl.load(ctx, linters, buildTags)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load packages for Go version %s", version)
+		}
+
+		lintCtxs = append(lintCtxs, lintCtx)
+	}
+
+	return lintCtxs, nil
+}
+
+var goVersionRe = regexp.MustCompile(`^(?:go)?(1\.\d+)$`)
+
+// goVersionBuildTag returns the release build tag of a Go version, e.g. `go1.21` for `1.21`.
+func goVersionBuildTag(version string) (string, error) {
+	m := goVersionRe.FindStringSubmatch(version)
+	if m == nil {
+		return "", fmt.Errorf("invalid Go version %q in run.go-versions, expected a version like 1.21", version)
+	}
+
+	return "go" + m[1], nil
+}
+
+func (cl *ContextLoader) load(ctx context.Context, linters []*linter.Config, buildTags []string) (*linter.Context, error) {
+	loadMode, err := cl.findLoadMode(linters)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgs, err := cl.loadPackages(ctx, loadMode, buildTags)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load packages")
 	}
 
+	var looseFiles bool
+	if files := cl.fileArgs(); len(files) != 0 {
+		if listErr := findListError(pkgs); listErr != nil {
+			cl.log.Warnf("The files %s aren't a loadable package (%s): only the linters without type info run on them",
+				strings.Join(cl.cfg.Run.Args, ", "), listErr.Msg)
+
+			pkgs = cl.loadLooseFiles(files)
+			looseFiles = true
+		}
+	}
+
 	deduplicatedPkgs := cl.filterDuplicatePackages(pkgs)
 
 	if len(deduplicatedPkgs) == 0 {
@@ -309,7 +427,80 @@ func (cl *ContextLoader) Load(ctx context.Context, linters []*linter.Config) (*l
 		LineCache: cl.lineCache,
 		PkgCache:  cl.pkgCache,
 		LoadGuard: cl.loadGuard,
+
+		LooseFiles: looseFiles,
 	}
 
 	return ret, nil
 }
+
+// fileArgs returns the absolute paths of the arguments if they are all Go files, nil otherwise.
+func (cl *ContextLoader) fileArgs() []string {
+	var files []string
+	for _, arg := range cl.cfg.Run.Args {
+		if !strings.HasSuffix(arg, ".go") {
+			return nil
+		}
+
+		info, err := os.Stat(arg)
+		if err != nil || !info.Mode().IsRegular() {
+			return nil
+		}
+
+		absPath, err := filepath.Abs(arg)
+		if err != nil {
+			return nil
+		}
+
+		files = append(files, absPath)
+	}
+
+	return files
+}
+
+// findListError returns the first error of `go list` of the packages, e.g. files of several packages or directories.
+func findListError(pkgs []*packages.Package) *packages.Error {
+	for _, pkg := range pkgs {
+		for i := range pkg.Errors {
+			// the compiler output (`# pkg` and the errors) is the one of a loadable package, e.g. with a syntax error:
+			// typecheck reports these errors.
+			if pkg.Errors[i].Kind == packages.ListError && !strings.HasPrefix(pkg.Errors[i].Msg, "# ") {
+				return &pkg.Errors[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadLooseFiles builds an ad-hoc package for each file, without type info:
+// the syntax-level linters parse the files themselves.
+func (cl *ContextLoader) loadLooseFiles(files []string) []*packages.Package {
+	fset := token.NewFileSet()
+
+	pkgs := make([]*packages.Package, 0, len(files))
+	for _, file := range files {
+		// the parsing errors are reported by the linters.
+		f, _ := parser.ParseFile(fset, file, nil, parser.PackageClauseOnly)
+
+		var name string
+		if f != nil && f.Name != nil {
+			name = f.Name.Name
+		}
+
+		pkg := &packages.Package{
+			ID:              file,
+			Name:            name,
+			PkgPath:         "command-line-arguments",
+			GoFiles:         []string{file},
+			CompiledGoFiles: []string{file},
+			Fset:            fset,
+		}
+		cl.loadGuard.AddMutexForPkg(pkg)
+
+		cl.debugf("Built loose file package: ID=%s Name=%s", pkg.ID, pkg.Name)
+		pkgs = append(pkgs, pkg)
+	}
+
+	return pkgs
+}
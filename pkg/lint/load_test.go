@@ -0,0 +1,229 @@
+package lint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/internal/pkgcache"
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/golinters/goanalysis/load"
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/timeutils"
+)
+
+func TestFindLoadMode(t *testing.T) {
+	syntaxLinters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "a"}).WithLoadFiles(),
+		linter.NewConfig(fakeLinter{name: "b"}),
+	}
+	allLinters := append([]*linter.Config{
+		linter.NewConfig(fakeLinter{name: "c"}).WithLoadForGoAnalysis(),
+		linter.NewConfig(fakeLinter{name: "d"}).WithLoadForGoAnalysis(),
+	}, syntaxLinters...)
+
+	testCases := []struct {
+		desc     string
+		loadMode string
+		linters  []*linter.Config
+		expected packages.LoadMode
+	}{
+		{
+			desc:     "auto syntax",
+			loadMode: LoadModeAuto,
+			linters:  syntaxLinters,
+			expected: packages.NeedModule | linter.LoadModeFiles,
+		},
+		{
+			desc:     "auto",
+			loadMode: LoadModeAuto,
+			linters:  allLinters,
+			expected: packages.NeedModule | linter.LoadModeGoAnalysis,
+		},
+		{
+			desc:     "default",
+			linters:  syntaxLinters,
+			expected: packages.NeedModule | linter.LoadModeGoAnalysis,
+		},
+		{
+			desc:     "syntax",
+			loadMode: LoadModeSyntax,
+			linters:  syntaxLinters,
+			expected: packages.NeedModule | linter.LoadModeFiles,
+		},
+		{
+			desc:     "full",
+			loadMode: LoadModeFull,
+			linters:  syntaxLinters,
+			expected: packages.NeedModule | linter.LoadModeGoAnalysis,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			cl := &ContextLoader{cfg: &config.Config{Run: config.Run{LoadMode: tc.loadMode}}}
+
+			loadMode, err := cl.findLoadMode(tc.linters)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, loadMode)
+		})
+	}
+}
+
+func TestFindLoadModeErrors(t *testing.T) {
+	linters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "a"}).WithLoadFiles(),
+		linter.NewConfig(fakeLinter{name: "c"}).WithLoadForGoAnalysis(),
+		linter.NewConfig(fakeLinter{name: "d"}).WithLoadForGoAnalysis(),
+	}
+
+	cl := &ContextLoader{cfg: &config.Config{Run: config.Run{LoadMode: LoadModeSyntax}}}
+	_, err := cl.findLoadMode(linters)
+	assert.EqualError(t, err, "load mode syntax doesn't load what the linters c, d need: use full")
+
+	cl = &ContextLoader{cfg: &config.Config{Run: config.Run{LoadMode: "types"}}}
+	_, err = cl.findLoadMode(linters)
+	assert.EqualError(t, err, "invalid load mode types, only (auto|syntax|full) allowed")
+}
+
+func TestLoadLooseFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	snippet := filepath.Join(dir, "snippet.go")
+	require.NoError(t, os.WriteFile(snippet, []byte("package snippet\n\nfunc f() { {{.Body}} }\n"), 0o600))
+	other := filepath.Join(dir, "other.go")
+	require.NoError(t, os.WriteFile(other, []byte("package other\n"), 0o600))
+
+	cl := &ContextLoader{
+		cfg:       &config.Config{Run: config.Run{Args: []string{snippet, other}}},
+		debugf:    logutils.Debug(logutils.DebugKeyLoader),
+		loadGuard: load.NewGuard(),
+	}
+
+	files := cl.fileArgs()
+	assert.Equal(t, []string{snippet, other}, files)
+
+	pkgs := cl.loadLooseFiles(files)
+	require.Len(t, pkgs, 2)
+	assert.Equal(t, "snippet", pkgs[0].Name)
+	assert.Equal(t, []string{snippet}, pkgs[0].CompiledGoFiles)
+	assert.Equal(t, "other", pkgs[1].Name)
+	assert.Equal(t, []string{other}, pkgs[1].CompiledGoFiles)
+}
+
+func TestFileArgs(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "a.go")
+	require.NoError(t, os.WriteFile(file, []byte("package a\n"), 0o600))
+
+	testCases := []struct {
+		desc string
+		args []string
+	}{
+		{desc: "no args"},
+		{desc: "package", args: []string{"./..."}},
+		{desc: "directory", args: []string{dir}},
+		{desc: "missing file", args: []string{file, filepath.Join(dir, "missing.go")}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			cl := &ContextLoader{cfg: &config.Config{Run: config.Run{Args: tc.args}}}
+			assert.Nil(t, cl.fileArgs())
+		})
+	}
+}
+
+func TestFindListError(t *testing.T) {
+	listErr := packages.Error{Msg: "found packages a (a.go) and b (b.go) in /tmp/lf", Kind: packages.ListError}
+	compileErr := packages.Error{Msg: "# command-line-arguments\n./c.go:3:1: syntax error", Kind: packages.ListError}
+	typeErr := packages.Error{Msg: "undefined: x", Kind: packages.TypeError}
+
+	assert.Nil(t, findListError([]*packages.Package{{Errors: []packages.Error{compileErr, typeErr}}}))
+	assert.Equal(t, &listErr, findListError([]*packages.Package{{Errors: []packages.Error{compileErr}}, {Errors: []packages.Error{listErr}}}))
+}
+
+func TestGoVersionBuildTag(t *testing.T) {
+	testCases := []struct {
+		version  string
+		expected string
+	}{
+		{version: "1.21", expected: "go1.21"},
+		{version: "go1.21", expected: "go1.21"},
+		{version: "1.9", expected: "go1.9"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.version, func(t *testing.T) {
+			tag, err := goVersionBuildTag(tc.version)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, tag)
+		})
+	}
+
+	// the release tags have no patch versions.
+	for _, version := range []string{"", "1", "1.21.3", "go1.21rc1", "2.0.x"} {
+		_, err := goVersionBuildTag(version)
+		assert.Error(t, err, version)
+	}
+}
+
+func TestLoadGoVersions(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/a\n\ngo 1.19\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"), []byte("package a\n"), 0o600))
+	// gated by a version newer than any toolchain: only the pass of this version loads it.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.go"), []byte("//go:build go1.999\n\npackage a\n"), 0o600))
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { require.NoError(t, os.Chdir(wd)) })
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	log.SetLevel(logutils.LogLevelError)
+
+	pkgCache, err := pkgcache.NewCache(timeutils.NewStopwatch("pkgcache", log), log)
+	require.NoError(t, err)
+
+	cfg := config.NewDefault()
+	cfg.Run.Args = []string{"./..."}
+	cfg.Run.GoVersions = []string{"1.19", "1.999"}
+
+	fileCache := fsutils.NewFileCache()
+	cl := NewContextLoader(cfg, log, goutil.NewEnv(log), fsutils.NewLineCache(fileCache), fileCache, pkgCache, load.NewGuard())
+
+	linters := []*linter.Config{linter.NewConfig(fakeLinter{name: "a"}).WithLoadFiles()}
+
+	lintCtxs, err := cl.LoadGoVersions(context.Background(), linters)
+	require.NoError(t, err)
+	require.Len(t, lintCtxs, 2)
+
+	fileNames := func(lintCtx *linter.Context) []string {
+		require.Len(t, lintCtx.Packages, 1)
+
+		var names []string
+		for _, file := range lintCtx.Packages[0].GoFiles {
+			names = append(names, filepath.Base(file))
+		}
+		return names
+	}
+	assert.Equal(t, []string{"a.go"}, fileNames(lintCtxs[0]))
+	assert.Equal(t, []string{"a.go", "b.go"}, fileNames(lintCtxs[1]))
+
+	cfg.Run.GoVersions = []string{"1.21.3"}
+	_, err = cl.LoadGoVersions(context.Background(), linters)
+	assert.EqualError(t, err, `invalid Go version "1.21.3" in run.go-versions, expected a version like 1.21`)
+}
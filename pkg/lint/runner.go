@@ -3,8 +3,12 @@ package lint
 import (
 	"context"
 	"fmt"
+	"go/token"
+	"runtime"
 	"runtime/debug"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
@@ -13,6 +17,8 @@ import (
 	"github.com/golangci/golangci-lint/internal/errorutil"
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/golinters"
+	"github.com/golangci/golangci-lint/pkg/golinters/goanalysis"
 	"github.com/golangci/golangci-lint/pkg/goutil"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
@@ -26,10 +32,109 @@ import (
 type Runner struct {
 	Processors []processors.Processor
 	Log        logutils.Log
+
+	// LinterIssuesCallback, if set, is called with the issues of each linter as soon as the linter finished,
+	// e.g. to display them progressively: the final issues are still returned once all the linters finished.
+	// These issues are unprocessed: they aren't filtered (e.g. excludes, nolint), deduplicated nor sorted,
+	// and they are reported once per package loading (see RunContexts).
+	// The callback must not modify them.
+	LinterIssuesCallback func(linterName string, issues []result.Issue)
+
+	maxTotal    *processors.MaxTotal
+	issueFilter *processors.IssueFilter
+
+	// scopeProcessors are the processors filtering issues only by their file.
+	scopeProcessors []processors.Processor
+
+	bench bool
+
+	// noTiming discards the timings of the stopwatches, whatever the log level is.
+	noTiming bool
+
+	// profileFiles is the count of the slowest files printed; fileTimings collects their times if it's positive.
+	profileFiles int
+	fileTimings  *timeutils.FileTimings
+
+	// shuffle runs the linters in a random order, from the seed, to detect the order-dependent bugs.
+	shuffle     bool
+	shuffleSeed int64
+
+	// linterStatuses are the statuses of the linters of the last run, by linter name.
+	linterStatuses map[string]LinterStatus
+
+	// linterDurations are the durations of the linters of the last run, by name,
+	// except the combined go/analysis linters: they are timed together, in goAnalysisDuration.
+	linterDurations    map[string]time.Duration
+	goAnalysisDuration time.Duration
+}
+
+// LinterStatus is the outcome of the run of a linter.
+type LinterStatus string
+
+const (
+	// LinterStatusIssues is the status of the linters with reported issues.
+	LinterStatusIssues LinterStatus = "issues"
+	// LinterStatusClean is the status of the linters which ran without reported issues:
+	// their issues, if any, were all filtered out, e.g. by skip-dirs or exclude rules.
+	LinterStatusClean LinterStatus = "clean"
+	// LinterStatusErrored is the status of the linters which failed.
+	LinterStatusErrored LinterStatus = "errored"
+	// LinterStatusSkipped is the status of the enabled linters which didn't run:
+	// the linters needing type info on the files outside a package, or disabled because of generics.
+	LinterStatusSkipped LinterStatus = "skipped"
+)
+
+// RunInputs are the processors reading their inputs once per run, e.g. from the standard input or the network,
+// or writing a store: the runners of the passes of a run share them (e.g. to confirm the fixes).
+type RunInputs struct {
+	compilerDiagnostics *processors.CompilerDiagnostics
+	triagedIssues       *processors.TriagedIssues
+	firstSeen           *processors.FirstSeen
+}
+
+func NewRunInputs(cfg *config.Config, log logutils.Log) (*RunInputs, error) {
+	compilerDiagnosticsProcessor, err := processors.NewCompilerDiagnostics(cfg.Issues.KnownCompilerDiagnostics,
+		log.Child(logutils.DebugKeyCompilerDiags))
+	if err != nil {
+		return nil, err
+	}
+
+	triagedIssuesProcessor, err := processors.NewTriagedIssues(cfg.Issues.TriagedFingerprints.URL,
+		cfg.Issues.TriagedFingerprints.FailClosed, log.Child(logutils.DebugKeyTriagedIssues))
+	if err != nil {
+		return nil, err
+	}
+
+	return &RunInputs{
+		compilerDiagnostics: compilerDiagnosticsProcessor,
+		triagedIssues:       triagedIssuesProcessor,
+		firstSeen:           processors.NewFirstSeen(cfg.Issues.FirstSeenStore, log.Child(logutils.DebugKeyFirstSeen)),
+	}, nil
 }
 
+// DeferFirstSeenSave makes the runners keep the fingerprints added to the first seen store until SaveFirstSeen:
+// the store is written once, with the issues of the last pass.
+func (i *RunInputs) DeferFirstSeenSave() {
+	i.firstSeen.DeferSave()
+}
+
+// SaveFirstSeen writes the fingerprints added by the last pass to the first seen store (see DeferFirstSeenSave).
+func (i *RunInputs) SaveFirstSeen() error {
+	return i.firstSeen.Save()
+}
+
+// NewRunner returns a runner processing the issues with the inputs, new inputs if nil.
 func NewRunner(cfg *config.Config, log logutils.Log, goenv *goutil.Env, es *lintersdb.EnabledSet,
-	lineCache *fsutils.LineCache, dbManager *lintersdb.Manager, pkgs []*gopackages.Package) (*Runner, error) {
+	lineCache *fsutils.LineCache, dbManager *lintersdb.Manager, pkgs []*gopackages.Package,
+	inputs *RunInputs) (*Runner, error) {
+	if inputs == nil {
+		var err error
+		inputs, err = NewRunInputs(cfg, log)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	skipFilesProcessor, err := processors.NewSkipFiles(cfg.Run.SkipFiles)
 	if err != nil {
 		return nil, err
@@ -37,9 +142,58 @@ func NewRunner(cfg *config.Config, log logutils.Log, goenv *goutil.Env, es *lint
 
 	skipDirs := cfg.Run.SkipDirs
 	if cfg.Run.UseDefaultSkipDirs {
-		skipDirs = append(skipDirs, packages.StdExcludeDirRegexps...)
+		stdSkipDirs, errStd := packages.GetStdExcludeDirRegexps(cfg.Run.DisableDefaultSkipDirs)
+		if errStd != nil {
+			return nil, errStd
+		}
+		skipDirs = append(skipDirs, stdSkipDirs...)
+	}
+	skipDirsProcessor, err := processors.NewSkipDirs(skipDirs, log.Child(logutils.DebugKeySkipDirs), cfg.Run.Args,
+		cfg.Run.GoFileExtensions)
+	if err != nil {
+		return nil, err
 	}
-	skipDirsProcessor, err := processors.NewSkipDirs(skipDirs, log.Child(logutils.DebugKeySkipDirs), cfg.Run.Args)
+
+	validatePositionsProcessor, err := processors.NewValidatePositions(cfg.Issues.InvalidPositions, lineCache)
+	if err != nil {
+		return nil, err
+	}
+
+	blameNewerThanProcessor, err := processors.NewBlameNewerThan(cfg.Issues.BlameNewerThan,
+		log.Child(logutils.DebugKeyBlameNewerThan))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid issues.blame-newer-than")
+	}
+
+	normalizePathCaseProcessor, err := processors.NewNormalizePathCase(cfg.Issues.NormalizePathCase)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid issues.normalize-path-case")
+	}
+
+	fileModifiedProcessor, err := processors.NewFileModified(cfg.Issues.FileModifiedAfter, cfg.Issues.FileModifiedBefore,
+		log.Child(logutils.DebugKeyFileModified))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid issues.file-modified-after or issues.file-modified-before")
+	}
+
+	skipVendorProcessor := processors.NewSkipVendor(cfg.Run.SkipVendor, pkgs, log.Child(logutils.DebugKeySkipVendor))
+
+	onlyTrackedFilesProcessor := processors.NewOnlyTrackedFiles(cfg.Run.OnlyTrackedFiles,
+		log.Child(logutils.DebugKeyOnlyTrackedFiles))
+
+	skipLargeFilesProcessor, err := processors.NewSkipLargeFiles(cfg.Issues.SkipFilesLargerThan, lineCache,
+		log.Child(logutils.DebugKeySkipLargeFiles))
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprintContextProcessor, err := processors.NewFingerprintContext(cfg.Output.FingerprintMode, lineCache,
+		log.Child(logutils.DebugKeyFingerprintContext))
+	if err != nil {
+		return nil, err
+	}
+
+	diffProcessor, err := getDiffProcessor(&cfg.Issues, log)
 	if err != nil {
 		return nil, err
 	}
@@ -65,39 +219,185 @@ func NewRunner(cfg *config.Config, log logutils.Log, goenv *goutil.Env, es *lint
 		}
 	}
 
+	pathExcludeRulesProcessor := getPathExcludeRulesProcessor(&cfg.Issues, log, lineCache)
+
+	sidecarAllowlistProcessor, err := processors.NewSidecarAllowlist(cfg.Issues.SidecarAllowlist,
+		cfg.Issues.SidecarAllowlistReportUnused, pkgs, log.Child(logutils.DebugKeySidecarAllowlist))
+	if err != nil {
+		return nil, err
+	}
+
+	coverageSeverityProcessor, err := processors.NewCoverageSeverity(cfg.Severity.CoverageProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	minReportSeverityProcessor, err := processors.NewMinReportSeverity(cfg.Issues.MinReportSeverity)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid issues.min-report-severity")
+	}
+
+	pathBaseProcessor, err := processors.NewPathBase(cfg.Output.PathBase, log.Child(logutils.DebugKeyPathBase))
+	if err != nil {
+		return nil, err
+	}
+
+	splitCheckIDsProcessor, err := processors.NewSplitCheckIDs(cfg.Output.SplitCheckIDs, cfg.Output.CheckIDFormats)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid output.check-id-formats")
+	}
+
+	messageTranslationsProcessor, err := processors.NewMessageTranslations(cfg.Output.MessageTranslations)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid output.message-translations")
+	}
+
+	shuffleSeed, shuffle, err := parseShuffleSeed(cfg.Run.ShuffleLinters)
+	if err != nil {
+		return nil, err
+	}
+	if shuffle {
+		// printed even without verbose output: it's needed to reproduce a failure.
+		fmt.Fprintf(logutils.StdErr, "Shuffling the linters with the seed %d: reproduce with --shuffle-linters=%d\n",
+			shuffleSeed, shuffleSeed)
+	}
+
+	maxTotalProcessor := processors.NewMaxTotal(cfg.Issues.MaxTotal, log.Child(logutils.DebugKeyMaxTotal))
+	issueFilterProcessor := processors.NewIssueFilter(nil)
+
+	var fileTimings *timeutils.FileTimings
+	if cfg.Run.ProfileFiles > 0 {
+		fileTimings = timeutils.NewFileTimings()
+	}
+
+	// shared by the scope processors: the files are scoped exactly as the issues.
+	cgoProcessor := processors.NewCgo(goenv)
+	pathPrettifierProcessor := processors.NewPathPrettifier()
+	autogeneratedExcludeProcessor := processors.NewAutogeneratedExclude(cfg.Issues.GeneratedFilesLinters, cfg.Run.GoFileExtensions)
+
 	return &Runner{
+		maxTotal:    maxTotalProcessor,
+		issueFilter: issueFilterProcessor,
+		scopeProcessors: []processors.Processor{
+			cgoProcessor,
+			pathPrettifierProcessor,
+			skipFilesProcessor,
+			skipDirsProcessor,
+			skipVendorProcessor,
+			skipLargeFilesProcessor,
+			onlyTrackedFilesProcessor,
+			autogeneratedExcludeProcessor,
+			pathExcludeRulesProcessor,
+		},
 		Processors: []processors.Processor{
-			processors.NewCgo(goenv),
+			cgoProcessor,
 
 			// Must go after Cgo.
-			processors.NewFilenameUnadjuster(pkgs, log.Child(logutils.DebugKeyFilenameUnadjuster)),
+			processors.NewFilenameUnadjuster(pkgs, log.Child(logutils.DebugKeyFilenameUnadjuster),
+				cfg.Issues.FollowLineDirectives),
 
 			// Must be before diff, nolint and exclude autogenerated processor at least.
-			processors.NewPathPrettifier(),
+			pathPrettifierProcessor,
+			// Must be after path prettifier, and before the deduplications: the paths of the same file become equal.
+			normalizePathCaseProcessor,
+			// Must be after path prettifier: the paths are compared with the real paths relative to the current directory.
+			processors.NewDedupSymlinks(cfg.Issues.DedupSymlinks),
+			// Must be before the processors reading the lines of the issues, e.g. source code.
+			validatePositionsProcessor,
+			// Must be before the processors using the line ranges, e.g. nolint and source code.
+			processors.NewMergeRanges(cfg.Issues.MergeRangeLinters),
 			skipFilesProcessor,
 			skipDirsProcessor, // must be after path prettifier
+			skipVendorProcessor,
+			skipLargeFilesProcessor,
+			onlyTrackedFilesProcessor,
 
-			processors.NewAutogeneratedExclude(),
+			autogeneratedExcludeProcessor,
 
 			// Must be before exclude because users see already marked output and configure excluding by it.
 			processors.NewIdentifierMarker(),
 
 			getExcludeProcessor(&cfg.Issues),
-			getExcludeRulesProcessor(&cfg.Issues, log, lineCache),
-			processors.NewNolint(log.Child(logutils.DebugKeyNolint), dbManager, enabledLinters),
-
-			processors.NewUniqByLine(cfg),
-			processors.NewDiff(cfg.Issues.Diff, cfg.Issues.DiffFromRevision, cfg.Issues.DiffPatchFilePath, cfg.Issues.WholeFiles),
+			getExcludeRulesProcessor(&cfg.Issues, log, lineCache, dbManager),
+			sidecarAllowlistProcessor,
+			processors.NewNoopFixes(cfg.Issues.ExcludeNoopFixes, lineCache, log.Child(logutils.DebugKeyNoopFixes)),
+			processors.NewInlineGenerated(cfg.Issues.InlineGeneratedMarker, lineCache, log.Child(logutils.DebugKeyInlineGenerated)),
+			processors.NewNolint(log.Child(logutils.DebugKeyNolint), dbManager, enabledLinters, cfg.Nolint.Directives).
+				WithExternalFile(cfg.Nolint.ExternalFile,
+					enabledLinters[golinters.NoLintLintName] != nil && !cfg.LintersSettings.NoLintLint.AllowUnused, pkgs).
+				WithWarnUnknownLinters(cfg.Nolint.WarnUnknownLinters, pkgs),
+			inputs.compilerDiagnostics, // must be before the typecheck texts are collapsed
+			processors.NewTypecheckCollapse(cfg.Issues.CollapseTypecheck, log.Child(logutils.DebugKeyTypecheckCollapse)),
+			processors.NewMinReportedComplexity(cfg.Issues.MinReportedComplexity),
+			processors.NewMinColumn(cfg.Issues.MinColumn, cfg.Issues.MinColumnPerLinter),
+			processors.NewAllowedDeprecations(cfg.Issues.AllowedDeprecatedSymbols),
+
+			processors.NewUniqByLine(cfg), // keeps the dropped duplicates for the severity rules below
+			processors.NewDedupTestVariants(cfg.Issues.DedupTestVariants),
+			diffProcessor,
+			processors.NewBlameAuthors(cfg.Issues.BlameIncludeAuthors, cfg.Issues.BlameExcludeAuthors,
+				log.Child(logutils.DebugKeyBlameAuthors)),
+			blameNewerThanProcessor,
+			fileModifiedProcessor,
+			// Must be before the limits: a rolled up run counts as one issue.
+			processors.NewRollupConsecutive(cfg.Issues.RollupConsecutive),
 			processors.NewMaxPerFileFromLinter(cfg),
 			processors.NewMaxSameIssues(cfg.Issues.MaxSameIssues, log.Child(logutils.DebugKeyMaxSameIssues), cfg),
 			processors.NewMaxFromLinter(cfg.Issues.MaxIssuesPerLinter, log.Child(logutils.DebugKeyMaxFromLinter), cfg),
-			processors.NewSourceCode(lineCache, log.Child(logutils.DebugKeySourceCode)),
+			// Must be after the exclusions and the limits per file, text and linter:
+			// the linters, files and packages are ranked by the counts of the issues left.
+			processors.NewMaxDistinctLinters(cfg.Issues.MaxDistinctLinters, log.Child(logutils.DebugKeyMaxDistinctLinters)),
+			processors.NewMaxFiles(cfg.Issues.MaxFiles, log.Child(logutils.DebugKeyMaxFiles)),
+			processors.NewPackageBudget(cfg.Issues.PackageBudget, cfg.Issues.LinterWeights,
+				log.Child(logutils.DebugKeyPackageBudget)),
+			processors.NewAutoFixable(),
+			processors.NewPackagePath(),
+			processors.NewModulePath(),
+			processors.NewEnclosingFunc(cfg.Output.IncludeEnclosingFunc, log.Child(logutils.DebugKeyEnclosingFunc)),
+			// Must be before the source tabs: the columns are still in bytes.
+			processors.NewIncludeOffsets(cfg.Output.IncludeOffsets, lineCache, log.Child(logutils.DebugKeyIncludeOffsets)),
+			processors.NewSourceCode(lineCache, log.Child(logutils.DebugKeySourceCode), cfg.Run.SourceReadConcurrency),
+			processors.NewSourceTabs(cfg.Output.SourceTabWidth),
+			fingerprintContextProcessor,
 			processors.NewPathShortener(),
-			getSeverityRulesProcessor(&cfg.Severity, log, lineCache),
+			getSeverityRulesProcessor(&cfg.Severity, log, lineCache), // resolves the severity of the uniq-by-line duplicates
+			coverageSeverityProcessor,                                // must be after the severity rules: the raised severities are the final ones
+			// Must be after the severities are assigned: the highest severity of a position wins.
+			processors.NewUniquePositions(cfg.Issues.UniquePositions, cfg.Issues.LinterWeights),
+			minReportSeverityProcessor, // must be after the severities are assigned
+			// Must be after the processors changing the fingerprints (source code, fingerprint context, etc.),
+			// and before the rewrites of the paths and the texts for the output below.
+			processors.NewFreezeFingerprints(),
+			inputs.triagedIssues,
+			inputs.firstSeen,
+			// Must be before the rewrites of the paths for the output: the globs match the paths relative to the current directory.
+			processors.NewFailOnPaths(cfg.Issues.FailOnPaths),
+			pathBaseProcessor, // must be before the path prefixer: the prefix is added to the paths relative to the base
 			processors.NewPathPrefixer(cfg.Output.PathPrefix),
+			// Must be after the processors matching the texts, and before the multi-line texts are split.
+			splitCheckIDsProcessor, // before the translations: these match the split messages
+			messageTranslationsProcessor,
+			processors.NewLinterMessagePrefix(cfg.Output.LinterMessagePrefix), // after the translations: the prefix is kept
+			processors.NewMultilineText(),
 			processors.NewSortResults(cfg),
+			// Must be after the sort: the issues with the same score stay sorted.
+			processors.NewConfidenceRanking(cfg.Issues.ConfidenceRanking, cfg.Issues.ConfidenceTop, cfg.Issues.LinterWeights),
+			maxTotalProcessor, // must be after the sort: the truncation is deterministic
+			// The last filter: the issue filter of the embedders sees the final issues.
+			issueFilterProcessor,
+			// Must be after all the filters: the count is the one of the issues to report.
+			processors.NewReportThreshold(cfg.Issues.ReportThreshold, log.Child(logutils.DebugKeyReportThreshold)),
+
+			// Must be the last: the indices are the positions in the final output.
+			processors.NewIndex(),
 		},
-		Log: log,
+		Log:          log,
+		bench:        cfg.Run.Bench,
+		noTiming:     cfg.Run.NoTiming,
+		profileFiles: cfg.Run.ProfileFiles,
+		fileTimings:  fileTimings,
+		shuffle:      shuffle,
+		shuffleSeed:  shuffleSeed,
 	}, nil
 }
 
@@ -141,13 +441,67 @@ func (r *Runner) runLinterSafe(ctx context.Context, lintCtx *linter.Context,
 	return issues, nil
 }
 
+// runLinterBench runs the linter like runLinterSafe, and prints its wall time and memory delta,
+// even if it found no issues.
+func (r *Runner) runLinterBench(ctx context.Context, lintCtx *linter.Context,
+	lc *linter.Config) ([]result.Issue, error) {
+	const MB = 1024 * 1024
+
+	var before, after runtime.MemStats
+
+	// don't count the garbage of the previous work.
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	startedAt := time.Now()
+
+	issues, err := r.runLinterSafe(ctx, lintCtx, lc)
+
+	elapsed := time.Since(startedAt)
+	runtime.ReadMemStats(&after)
+
+	heapDelta := float64(int64(after.HeapAlloc)-int64(before.HeapAlloc)) / MB
+	allocated := float64(after.TotalAlloc-before.TotalAlloc) / MB
+
+	fmt.Fprintf(logutils.StdErr, "Bench %s: wall time %s, heap delta %+.1fMB, allocated %.1fMB, %d issues\n",
+		lc.Name(), elapsed, heapDelta, allocated, len(issues))
+
+	return issues, err
+}
+
+// printSlowestFiles prints the slowest files to analyze, if profiled with --profile-files.
+func (r *Runner) printSlowestFiles() {
+	if r.fileTimings == nil {
+		return
+	}
+
+	top := r.fileTimings.Top(r.profileFiles)
+
+	fmt.Fprintf(logutils.StdErr, "Slowest %d files to lint (estimated from the analysis times of their packages):\n", len(top))
+	for _, fd := range top {
+		file, err := fsutils.ShortestRelPath(fd.File, "")
+		if err != nil {
+			file = fd.File
+		}
+
+		fmt.Fprintf(logutils.StdErr, "  %s: %s\n", file, fd.Duration.Round(time.Microsecond))
+	}
+}
+
+// stopwatchLog returns the log of the timings of the stopwatches, discarding them with --no-timing.
+func (r Runner) stopwatchLog() logutils.Log {
+	if r.noTiming {
+		return logutils.NewDiscardLog()
+	}
+	return r.Log
+}
+
 type processorStat struct {
 	inCount  int
 	outCount int
 }
 
 func (r Runner) processLintResults(inIssues []result.Issue) []result.Issue {
-	sw := timeutils.NewStopwatch("processing", r.Log)
+	sw := timeutils.NewStopwatch("processing", r.stopwatchLog())
 
 	var issuesBefore, issuesAfter int
 	statPerProcessor := map[string]processorStat{}
@@ -189,8 +543,188 @@ func (r Runner) printPerProcessorStat(stat map[string]processorStat) {
 	}
 }
 
-func (r Runner) Run(ctx context.Context, linters []*linter.Config, lintCtx *linter.Context) ([]result.Issue, error) {
-	sw := timeutils.NewStopwatch("linters", r.Log)
+func (r *Runner) Run(ctx context.Context, linters []*linter.Config, lintCtx *linter.Context) ([]result.Issue, error) {
+	r.linterStatuses = map[string]LinterStatus{}
+	r.linterDurations = map[string]time.Duration{}
+	r.goAnalysisDuration = 0
+
+	issues, err := r.runLinters(ctx, linters, lintCtx)
+	issues = r.processLintResults(issues)
+	r.setLinterStatusesIssues(issues)
+	r.printSlowestFiles()
+
+	return issues, err
+}
+
+// RunContexts runs the linters on each context (e.g. one per Go version),
+// then processes the union of the issues, deduplicated, once.
+func (r *Runner) RunContexts(ctx context.Context, linters []*linter.Config, lintCtxs []*linter.Context) ([]result.Issue, error) {
+	if len(lintCtxs) == 1 {
+		return r.Run(ctx, linters, lintCtxs[0])
+	}
+
+	r.linterStatuses = map[string]LinterStatus{}
+	r.linterDurations = map[string]time.Duration{}
+	r.goAnalysisDuration = 0
+
+	var (
+		lintErrors *multierror.Error
+		issues     []result.Issue
+		seen       = map[string]bool{}
+	)
+
+	for _, lintCtx := range lintCtxs {
+		passIssues, err := r.runLinters(ctx, linters, lintCtx)
+		if err != nil {
+			lintErrors = multierror.Append(lintErrors, err)
+		}
+
+		for i := range passIssues {
+			// the source lines aren't set yet: the fingerprint doesn't include the position of the issue.
+			key := fmt.Sprintf("%s:%s:%d:%d", passIssues[i].Fingerprint(), passIssues[i].FromLinter,
+				passIssues[i].Line(), passIssues[i].Column())
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+			issues = append(issues, passIssues[i])
+		}
+	}
+
+	issues = r.processLintResults(issues)
+	r.setLinterStatusesIssues(issues)
+	r.printSlowestFiles()
+
+	return issues, lintErrors.ErrorOrNil()
+}
+
+// LinterStatuses returns the status of each linter run by the last Run or RunContexts, by linter name:
+// the go/analysis linters combined in a metalinter are listed by their own names.
+// With several contexts, a linter failing in any of them is errored, and a linter is skipped only if it ran in none of them.
+func (r *Runner) LinterStatuses() map[string]LinterStatus {
+	return r.linterStatuses
+}
+
+// SetIssueFilter sets a predicate keeping only the issues for which it returns true, nil keeps all the issues:
+// it's the filter of the programs embedding golangci-lint, for the filters not expressible in the configuration.
+// It runs after all the other filters, the sort and the max-total truncation:
+// only the report threshold, counting the kept issues, and the indexing of the issues run after it.
+func (r *Runner) SetIssueFilter(keep func(result.Issue) bool) {
+	r.issueFilter.SetKeep(keep)
+}
+
+// MaxTotalReached returns the count of issues before the truncation, and true if the issues.max-total limit was reached.
+func (r *Runner) MaxTotalReached() (int, bool) {
+	return r.maxTotal.Total(), r.maxTotal.Reached()
+}
+
+// LintersByStatus returns the sorted names of the linters of LinterStatuses, by status.
+func (r *Runner) LintersByStatus() map[LinterStatus][]string {
+	byStatus := map[LinterStatus][]string{}
+	for name, status := range r.linterStatuses {
+		byStatus[status] = append(byStatus[status], name)
+	}
+
+	for _, names := range byStatus {
+		sort.Strings(names)
+	}
+
+	return byStatus
+}
+
+// LinterDurations returns the durations of the linters of the last run, by name, summed over the contexts:
+// the go/analysis linters combined in a metalinter aren't listed, see GoAnalysisDuration.
+func (r *Runner) LinterDurations() map[string]time.Duration {
+	return r.linterDurations
+}
+
+// GoAnalysisDuration returns the duration of the go/analysis linters of the last run combined in a metalinter,
+// summed over the contexts: they run together, on the same packages, so they can't be timed separately.
+func (r *Runner) GoAnalysisDuration() time.Duration {
+	return r.goAnalysisDuration
+}
+
+func (r *Runner) setLinterStatuses(lc *linter.Config, err error) {
+	names := []string{lc.Name()}
+	if ml, ok := lc.Linter.(*goanalysis.MetaLinter); ok {
+		names = ml.LinterNames()
+	}
+
+	if _, ok := lc.Linter.(*linter.Noop); ok && err == nil {
+		r.setLinterStatusesSkipped(names)
+		return
+	}
+
+	for _, name := range names {
+		switch {
+		case err != nil:
+			r.linterStatuses[name] = LinterStatusErrored
+		case r.linterStatuses[name] == "", r.linterStatuses[name] == LinterStatusSkipped:
+			r.linterStatuses[name] = LinterStatusClean
+		}
+	}
+}
+
+// setLinterStatusesSkipped sets the status of the linters which didn't run, unless they ran in another context.
+func (r *Runner) setLinterStatusesSkipped(names []string) {
+	if r.linterStatuses == nil {
+		return
+	}
+
+	for _, name := range names {
+		if r.linterStatuses[name] == "" {
+			r.linterStatuses[name] = LinterStatusSkipped
+		}
+	}
+}
+
+// setLinterStatusesIssues sets the status of the clean linters with reported issues.
+func (r *Runner) setLinterStatusesIssues(issues []result.Issue) {
+	for i := range issues {
+		if r.linterStatuses[issues[i].FromLinter] == LinterStatusClean {
+			r.linterStatuses[issues[i].FromLinter] = LinterStatusIssues
+		}
+	}
+}
+
+// syntaxOnlyLinters returns the linters needing only the files of the packages, without type info:
+// the other ones are skipped with a warning.
+func (r *Runner) syntaxOnlyLinters(linters []*linter.Config) []*linter.Config {
+	var ret []*linter.Config
+	var skipped []string
+	for _, lc := range linters {
+		if ml, ok := lc.Linter.(*goanalysis.MetaLinter); ok {
+			syntaxOnly, others := ml.SyntaxOnly()
+			skipped = append(skipped, others...)
+			if syntaxOnly != nil {
+				syntaxOnlyConfig := *lc
+				syntaxOnlyConfig.Linter = syntaxOnly
+				syntaxOnlyConfig.LoadMode = linter.LoadModeFiles
+				ret = append(ret, &syntaxOnlyConfig)
+			}
+			continue
+		}
+
+		if lc.LoadMode&^linter.LoadModeFiles != 0 {
+			skipped = append(skipped, lc.Name())
+			continue
+		}
+
+		ret = append(ret, lc)
+	}
+
+	if len(skipped) != 0 {
+		sort.Strings(skipped)
+		r.setLinterStatusesSkipped(skipped)
+		r.Log.Warnf("Skipped the linters needing type info on the files outside a package: %s", strings.Join(skipped, ", "))
+	}
+
+	return ret
+}
+
+func (r *Runner) runLinters(ctx context.Context, linters []*linter.Config, lintCtx *linter.Context) ([]result.Issue, error) {
+	sw := timeutils.NewStopwatch("linters", r.stopwatchLog())
 	defer sw.Print()
 
 	var (
@@ -198,21 +732,91 @@ func (r Runner) Run(ctx context.Context, linters []*linter.Config, lintCtx *lint
 		issues     []result.Issue
 	)
 
+	// the analyzers of the linters record the times of the files, if profiled.
+	lintCtx.FileTimings = r.fileTimings
+
+	runLinter := r.runLinterSafe
+	if r.bench {
+		runLinter = r.runLinterBench
+	}
+
+	if lintCtx.LooseFiles {
+		linters = r.syntaxOnlyLinters(linters)
+	}
+
+	if r.shuffle {
+		linters = shuffleLinters(linters, r.shuffleSeed)
+	}
+
 	for _, lc := range linters {
 		lc := lc
 		sw.TrackStage(lc.Name(), func() {
-			linterIssues, err := r.runLinterSafe(ctx, lintCtx, lc)
+			linterIssues, err := runLinter(ctx, lintCtx, lc)
+			r.setLinterStatuses(lc, err)
 			if err != nil {
 				lintErrors = multierror.Append(lintErrors, fmt.Errorf("can't run linter %s: %w", lc.Linter.Name(), err))
 				r.Log.Warnf("Can't run linter %s: %v", lc.Linter.Name(), err)
 
 				return
 			}
+
+			if r.LinterIssuesCallback != nil {
+				r.LinterIssuesCallback(lc.Name(), linterIssues)
+			}
+
 			issues = append(issues, linterIssues...)
 		})
 	}
 
-	return r.processLintResults(issues), lintErrors.ErrorOrNil()
+	if r.linterDurations != nil {
+		durations := sw.StageDurations()
+		for _, lc := range linters {
+			if _, ok := lc.Linter.(*goanalysis.MetaLinter); ok {
+				r.goAnalysisDuration += durations[lc.Name()]
+				continue
+			}
+
+			r.linterDurations[lc.Name()] += durations[lc.Name()]
+		}
+	}
+
+	return issues, lintErrors.ErrorOrNil()
+}
+
+// LinterFiles returns the sorted list of the files of the packages whose issues from the linter can be reported:
+// the files are scoped by the same skip-files, skip-dirs, autogenerated and exclude-rules (by path) logic as issues.
+func (r *Runner) LinterFiles(linterName string, pkgs []*gopackages.Package) ([]string, error) {
+	seen := map[string]bool{}
+	var issues []result.Issue
+	for _, pkg := range pkgs {
+		for _, file := range pkg.CompiledGoFiles {
+			if seen[file] {
+				continue
+			}
+			seen[file] = true
+
+			issues = append(issues, result.Issue{
+				FromLinter: linterName,
+				Pos:        token.Position{Filename: file, Line: 1},
+			})
+		}
+	}
+
+	for _, p := range r.scopeProcessors {
+		var err error
+		issues, err = p.Process(issues)
+		if err != nil {
+			return nil, fmt.Errorf("can't scope files by %s processor: %w", p.Name(), err)
+		}
+	}
+
+	files := make([]string, 0, len(issues))
+	for i := range issues {
+		files = append(files, issues[i].FilePath())
+	}
+	sort.Strings(files)
+
+	return files, nil
 }
 
 func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch, statPerProcessor map[string]processorStat) []result.Issue {
@@ -242,6 +846,26 @@ func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch, s
 	return issues
 }
 
+func getDiffProcessor(cfg *config.Issues, log logutils.Log) (processors.Processor, error) {
+	if (cfg.DiffWorkingTree || cfg.DiffStaged) &&
+		(cfg.Diff || cfg.DiffFromRevision != "" || cfg.DiffPatchFilePath != "" || cfg.DiffByFunction) {
+		return nil, errors.New("issues.diff-working-tree and issues.diff-staged can't be combined with issues.new, " +
+			"issues.new-from-rev, issues.new-from-patch or issues.diff-by-function")
+	}
+
+	if cfg.DiffByFunction {
+		if cfg.DiffAnnotate {
+			return nil, errors.New("issues.diff-annotate can't be combined with issues.diff-by-function")
+		}
+
+		// the changed functions are used instead of the changed lines.
+		return processors.NewDiffByFunction(cfg.DiffByFunction, cfg.DiffFromRevision, log.Child(logutils.DebugKeyDiffByFunction))
+	}
+
+	return processors.NewDiff(cfg.Diff, cfg.DiffFromRevision, cfg.DiffPatchFilePath, cfg.WholeFiles, cfg.DiffAnnotate).
+		WithWorkingTree(cfg.DiffWorkingTree, cfg.DiffStaged), nil
+}
+
 func getExcludeProcessor(cfg *config.Issues) processors.Processor {
 	var excludeTotalPattern string
 
@@ -259,7 +883,8 @@ func getExcludeProcessor(cfg *config.Issues) processors.Processor {
 	return excludeProcessor
 }
 
-func getExcludeRulesProcessor(cfg *config.Issues, log logutils.Log, lineCache *fsutils.LineCache) processors.Processor {
+func getExcludeRulesProcessor(cfg *config.Issues, log logutils.Log, lineCache *fsutils.LineCache,
+	dbManager *lintersdb.Manager) processors.Processor {
 	var excludeRules []processors.ExcludeRule
 	for _, r := range cfg.ExcludeRules {
 		excludeRules = append(excludeRules, processors.ExcludeRule{
@@ -269,20 +894,41 @@ func getExcludeRulesProcessor(cfg *config.Issues, log logutils.Log, lineCache *f
 				Path:    r.Path,
 				Linters: r.Linters,
 			},
+			CheckIDs: r.CheckIDs,
+			// the rules of the configuration come first: their indices are the ones of the configuration.
+			WarnIfUnused: cfg.WarnUnusedExcludeRules,
 		})
 	}
 
 	if cfg.UseDefaultExcludes {
-		for _, r := range config.GetExcludePatterns(cfg.IncludeDefaultExcludes) {
+		for _, r := range cfg.GetDefaultExcludePatterns() {
+			var linters []string
+			if r.Linter != "" {
+				linters = []string{r.Linter}
+			}
+
 			excludeRules = append(excludeRules, processors.ExcludeRule{
 				BaseRule: processors.BaseRule{
 					Text:    r.Pattern,
-					Linters: []string{r.Linter},
+					Linters: linters,
 				},
 			})
 		}
 	}
 
+	if cfg.UseLinterRecommendedExcludes {
+		for _, lc := range dbManager.GetAllSupportedLinterConfigs() {
+			for _, pattern := range lc.RecommendedExcludes {
+				excludeRules = append(excludeRules, processors.ExcludeRule{
+					BaseRule: processors.BaseRule{
+						Text:    pattern,
+						Linters: []string{lc.Name()},
+					},
+				})
+			}
+		}
+	}
+
 	var excludeRulesProcessor processors.Processor
 	if cfg.ExcludeCaseSensitive {
 		excludeRulesProcessor = processors.NewExcludeRulesCaseSensitive(
@@ -301,6 +947,29 @@ func getExcludeRulesProcessor(cfg *config.Issues, log logutils.Log, lineCache *f
 	return excludeRulesProcessor
 }
 
+// getPathExcludeRulesProcessor returns the processor of the exclude rules matching only by path and linters.
+func getPathExcludeRulesProcessor(cfg *config.Issues, log logutils.Log, lineCache *fsutils.LineCache) processors.Processor {
+	var excludeRules []processors.ExcludeRule
+	for _, r := range cfg.ExcludeRules {
+		if r.Text != "" || r.Source != "" || len(r.CheckIDs) != 0 {
+			continue
+		}
+
+		excludeRules = append(excludeRules, processors.ExcludeRule{
+			BaseRule: processors.BaseRule{
+				Path:    r.Path,
+				Linters: r.Linters,
+			},
+		})
+	}
+
+	if cfg.ExcludeCaseSensitive {
+		return processors.NewExcludeRulesCaseSensitive(excludeRules, lineCache, log.Child(logutils.DebugKeyExcludeRules))
+	}
+
+	return processors.NewExcludeRules(excludeRules, lineCache, log.Child(logutils.DebugKeyExcludeRules))
+}
+
 func getSeverityRulesProcessor(cfg *config.Severity, log logutils.Log, lineCache *fsutils.LineCache) processors.Processor {
 	var severityRules []processors.SeverityRule
 	for _, r := range cfg.Rules {
@@ -319,6 +988,7 @@ func getSeverityRulesProcessor(cfg *config.Severity, log logutils.Log, lineCache
 	if cfg.CaseSensitive {
 		severityRulesProcessor = processors.NewSeverityRulesCaseSensitive(
 			cfg.Default,
+			cfg.DefaultPerLinter,
 			severityRules,
 			lineCache,
 			log.Child(logutils.DebugKeySeverityRules),
@@ -326,6 +996,7 @@ func getSeverityRulesProcessor(cfg *config.Severity, log logutils.Log, lineCache
 	} else {
 		severityRulesProcessor = processors.NewSeverityRules(
 			cfg.Default,
+			cfg.DefaultPerLinter,
 			severityRules,
 			lineCache,
 			log.Child(logutils.DebugKeySeverityRules),
@@ -0,0 +1,303 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/golinters/goanalysis"
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+)
+
+type fakeLinter struct {
+	name   string
+	issues []result.Issue
+	err    error
+}
+
+func (l fakeLinter) Run(_ context.Context, _ *linter.Context) ([]result.Issue, error) {
+	return l.issues, l.err
+}
+
+func (l fakeLinter) Name() string { return l.name }
+
+func (l fakeLinter) Desc() string { return "" }
+
+func newFakeLinterIssue(linterName string, line int) result.Issue {
+	return result.Issue{
+		FromLinter: linterName,
+		Text:       "issue",
+		Pos:        token.Position{Filename: "a.go", Line: line},
+	}
+}
+
+func TestRunner_LinterIssuesCallback(t *testing.T) {
+	linters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "a", issues: []result.Issue{newFakeLinterIssue("a", 1), newFakeLinterIssue("a", 2)}}),
+		linter.NewConfig(fakeLinter{name: "b"}),
+		linter.NewConfig(fakeLinter{name: "c", err: errors.New("failure")}),
+	}
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	log.SetLevel(logutils.LogLevelError)
+
+	got := map[string][]result.Issue{}
+	r := Runner{
+		// the final issues are processed, unlike the ones of the callback.
+		Processors: []processors.Processor{processors.NewMaxFromLinter(1, log, config.NewDefault())},
+		Log:        log,
+		LinterIssuesCallback: func(linterName string, issues []result.Issue) {
+			got[linterName] = issues
+		},
+	}
+
+	issues, err := r.Run(context.Background(), linters, &linter.Context{})
+	require.Error(t, err)
+
+	expected := map[string][]result.Issue{
+		"a": {newFakeLinterIssue("a", 1), newFakeLinterIssue("a", 2)},
+		"b": nil,
+	}
+	assert.Equal(t, expected, got)
+	assert.Equal(t, []result.Issue{newFakeLinterIssue("a", 1)}, issues)
+}
+
+func TestRunner_LinterStatuses(t *testing.T) {
+	linters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "a", issues: []result.Issue{newFakeLinterIssue("a", 1)}}),
+		linter.NewConfig(fakeLinter{name: "b"}),
+		linter.NewConfig(fakeLinter{name: "c", err: errors.New("failure")}),
+		// all the issues are filtered out: the linter is clean.
+		linter.NewConfig(fakeLinter{name: "d", issues: []result.Issue{newFakeLinterIssue("d", 1)}}),
+	}
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	log.SetLevel(logutils.LogLevelError)
+
+	r := Runner{
+		Processors: []processors.Processor{filterLinterProcessor("d")},
+		Log:        log,
+	}
+
+	_, err := r.Run(context.Background(), linters, &linter.Context{})
+	require.Error(t, err)
+
+	expected := map[string]LinterStatus{
+		"a": LinterStatusIssues,
+		"b": LinterStatusClean,
+		"c": LinterStatusErrored,
+		"d": LinterStatusClean,
+	}
+	assert.Equal(t, expected, r.LinterStatuses())
+
+	expectedByStatus := map[LinterStatus][]string{
+		LinterStatusIssues:  {"a"},
+		LinterStatusClean:   {"b", "d"},
+		LinterStatusErrored: {"c"},
+	}
+	assert.Equal(t, expectedByStatus, r.LintersByStatus())
+}
+
+func TestRunner_LinterStatusesSkipped(t *testing.T) {
+	linters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "a"}),
+		linter.NewConfig(fakeLinter{name: "b"}).WithLoadForGoAnalysis(),
+	}
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	log.SetLevel(logutils.LogLevelError)
+
+	r := Runner{Log: log}
+
+	// the linter needing type info is skipped on the loose files, it isn't skipped if it ran in another context.
+	_, err := r.RunContexts(context.Background(), linters, []*linter.Context{{LooseFiles: true}, {LooseFiles: true}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]LinterStatus{"a": LinterStatusClean, "b": LinterStatusSkipped}, r.LinterStatuses())
+
+	_, err = r.RunContexts(context.Background(), linters, []*linter.Context{{LooseFiles: true}, {}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]LinterStatus{"a": LinterStatusClean, "b": LinterStatusClean}, r.LinterStatuses())
+}
+
+// filterLinterProcessor drops the issues of the linter.
+type filterLinterProcessor string
+
+func (p filterLinterProcessor) Process(issues []result.Issue) ([]result.Issue, error) {
+	var ret []result.Issue
+	for i := range issues {
+		if issues[i].FromLinter != string(p) {
+			ret = append(ret, issues[i])
+		}
+	}
+	return ret, nil
+}
+
+func (p filterLinterProcessor) Name() string { return "filter_linter" }
+
+func (p filterLinterProcessor) Finish() {}
+
+func TestRunner_SyntaxOnlyLinters(t *testing.T) {
+	newAnalysisLinter := func(name string, loadMode goanalysis.LoadMode) *goanalysis.Linter {
+		return goanalysis.NewLinter(name, "", []*analysis.Analyzer{{Name: name}}, nil).WithLoadMode(loadMode)
+	}
+
+	ml := goanalysis.NewMetaLinter([]*goanalysis.Linter{
+		newAnalysisLinter("gofmt", goanalysis.LoadModeSyntax),
+		newAnalysisLinter("govet", goanalysis.LoadModeTypesInfo),
+	})
+
+	linters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "a"}).WithLoadFiles(),
+		linter.NewConfig(fakeLinter{name: "b"}).WithLoadForGoAnalysis(),
+		linter.NewConfig(ml).WithLoadForGoAnalysis(),
+	}
+
+	log := logutils.NewMockLog()
+	log.On("Warnf", "Skipped the linters needing type info on the files outside a package: %s", "b, govet")
+
+	r := Runner{Log: log}
+
+	syntaxOnly := r.syntaxOnlyLinters(linters)
+	require.Len(t, syntaxOnly, 2)
+	assert.Equal(t, "a", syntaxOnly[0].Name())
+
+	syntaxOnlyML, ok := syntaxOnly[1].Linter.(*goanalysis.MetaLinter)
+	require.True(t, ok)
+	assert.Equal(t, []string{"gofmt"}, syntaxOnlyML.LinterNames())
+	assert.Equal(t, linter.LoadModeFiles, syntaxOnly[1].LoadMode)
+
+	log.AssertExpectations(t)
+}
+
+func TestPathExcludeRulesProcessor(t *testing.T) {
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	log.SetLevel(logutils.LogLevelError)
+
+	cfg := &config.Issues{
+		ExcludeRules: []config.ExcludeRule{
+			{BaseRule: config.BaseRule{Path: `^gen/`}},
+			// these rules don't exclude the whole files.
+			{BaseRule: config.BaseRule{Path: `^other/`, Text: "issue"}},
+			{BaseRule: config.BaseRule{Path: `^other/`}, CheckIDs: []string{"SA1019"}},
+		},
+	}
+
+	newFileIssue := func(path string) result.Issue {
+		return result.Issue{FromLinter: "a", Text: "issue", Pos: token.Position{Filename: path, Line: 1}}
+	}
+	issues := []result.Issue{newFileIssue("gen/a.go"), newFileIssue("other/a.go")}
+
+	p := getPathExcludeRulesProcessor(cfg, log, nil)
+	assert.IsType(t, &processors.ExcludeRules{}, p)
+
+	scoped, err := p.Process(issues)
+	require.NoError(t, err)
+	assert.Equal(t, []result.Issue{newFileIssue("other/a.go")}, scoped)
+
+	// the scope matches the issues like the exclude rules.
+	cfg.ExcludeCaseSensitive = true
+	p = getPathExcludeRulesProcessor(cfg, log, nil)
+	assert.IsType(t, &processors.ExcludeRulesCaseSensitive{}, p)
+
+	scoped, err = p.Process(issues)
+	require.NoError(t, err)
+	assert.Equal(t, []result.Issue{newFileIssue("other/a.go")}, scoped)
+}
+
+func TestNewRunnerSharedInputs(t *testing.T) {
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	log.SetLevel(logutils.LogLevelError)
+
+	diagnosticsPath := filepath.Join(t.TempDir(), "diagnostics.txt")
+	require.NoError(t, os.WriteFile(diagnosticsPath, []byte("a.go:1:2: undefined: x\n"), 0o600))
+
+	cfg := config.NewDefault()
+	cfg.Issues.KnownCompilerDiagnostics = diagnosticsPath
+
+	inputs, err := NewRunInputs(cfg, log)
+	require.NoError(t, err)
+
+	// the inputs are read once: the runners of the next passes don't read them again.
+	require.NoError(t, os.Remove(diagnosticsPath))
+
+	dbManager := lintersdb.NewManager(cfg, log)
+	es := lintersdb.NewEnabledSet(dbManager, lintersdb.NewValidator(dbManager), log, cfg)
+
+	for i := 0; i < 2; i++ {
+		r, err := NewRunner(cfg, log, goutil.NewEnv(log), es, nil, dbManager, nil, inputs)
+		require.NoError(t, err)
+		assert.Contains(t, r.Processors, processors.Processor(inputs.compilerDiagnostics))
+		assert.Contains(t, r.Processors, processors.Processor(inputs.firstSeen))
+	}
+
+	_, err = NewRunner(cfg, log, goutil.NewEnv(log), es, nil, dbManager, nil, nil)
+	assert.ErrorContains(t, err, "can't open the compiler diagnostics")
+}
+
+// fakeContextsLinter reports the issues of each context.
+type fakeContextsLinter struct {
+	name   string
+	issues map[*linter.Context][]result.Issue
+}
+
+func (l fakeContextsLinter) Run(_ context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	return l.issues[lintCtx], nil
+}
+
+func (l fakeContextsLinter) Name() string { return l.name }
+
+func (l fakeContextsLinter) Desc() string { return "" }
+
+func TestRunner_RunContextsDedup(t *testing.T) {
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	log.SetLevel(logutils.LogLevelError)
+
+	atColumn := func(issue result.Issue, column int) result.Issue {
+		issue.Pos.Column = column
+		return issue
+	}
+	withText := func(issue result.Issue, text string) result.Issue {
+		issue.Text = text
+		return issue
+	}
+
+	first, second := &linter.Context{}, &linter.Context{}
+	issue := newFakeLinterIssue("a", 1)
+
+	a := fakeContextsLinter{name: "a", issues: map[*linter.Context][]result.Issue{
+		first: {issue, newFakeLinterIssue("a", 2)},
+		// the issues are the same if they have the same fingerprint, linter, line and column.
+		second: {issue, newFakeLinterIssue("a", 3), atColumn(issue, 5), withText(issue, "other")},
+	}}
+	b := fakeContextsLinter{name: "b", issues: map[*linter.Context][]result.Issue{
+		second: {newFakeLinterIssue("b", 1)},
+	}}
+
+	r := Runner{Log: log}
+	issues, err := r.RunContexts(context.Background(),
+		[]*linter.Config{linter.NewConfig(a), linter.NewConfig(b)}, []*linter.Context{first, second})
+	require.NoError(t, err)
+
+	expected := []result.Issue{
+		issue,
+		newFakeLinterIssue("a", 2),
+		newFakeLinterIssue("a", 3),
+		atColumn(issue, 5),
+		withText(issue, "other"),
+		newFakeLinterIssue("b", 1),
+	}
+	assert.Equal(t, expected, issues)
+}
@@ -0,0 +1,60 @@
+package lint
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+)
+
+// ShuffleLintersRandom is the value of run.shuffle-linters shuffling with a random seed.
+const ShuffleLintersRandom = "random"
+
+// parseShuffleSeed parses run.shuffle-linters: "" (no shuffling), "random" or a seed.
+func parseShuffleSeed(value string) (seed int64, shuffle bool, err error) {
+	switch value {
+	case "":
+		return 0, false, nil
+	case ShuffleLintersRandom:
+		return time.Now().UnixNano(), true, nil
+	}
+
+	seed, err = strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid run.shuffle-linters %q: expected %q or an integer seed", value, ShuffleLintersRandom)
+	}
+
+	return seed, true, nil
+}
+
+// shuffleLinters returns the linters in a random order, the same one for a seed.
+// The order constraints of the linters are kept: the linters changing the types and the last linter stay at the end.
+func shuffleLinters(linters []*linter.Config, seed int64) []*linter.Config {
+	shuffled := make([]*linter.Config, len(linters))
+	copy(shuffled, linters)
+
+	rnd := rand.New(rand.NewSource(seed)) //nolint:gosec // not used for security
+	rnd.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	rank := func(lc *linter.Config) int {
+		switch {
+		case lc.Name() == linter.LastLinter:
+			return 2
+		case lc.DoesChangeTypes:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		return rank(shuffled[i]) < rank(shuffled[j])
+	})
+
+	return shuffled
+}
@@ -0,0 +1,56 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+)
+
+func TestParseShuffleSeed(t *testing.T) {
+	seed, shuffle, err := parseShuffleSeed("")
+	require.NoError(t, err)
+	assert.False(t, shuffle)
+	assert.Zero(t, seed)
+
+	seed, shuffle, err = parseShuffleSeed("42")
+	require.NoError(t, err)
+	assert.True(t, shuffle)
+	assert.Equal(t, int64(42), seed)
+
+	_, shuffle, err = parseShuffleSeed(ShuffleLintersRandom)
+	require.NoError(t, err)
+	assert.True(t, shuffle)
+
+	_, _, err = parseShuffleSeed("often")
+	assert.EqualError(t, err, `invalid run.shuffle-linters "often": expected "random" or an integer seed`)
+}
+
+func TestShuffleLinters(t *testing.T) {
+	var linters []*linter.Config
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		linters = append(linters, linter.NewConfig(fakeLinter{name: name}))
+	}
+	changesTypes := linter.NewConfig(fakeLinter{name: "unused"})
+	changesTypes.DoesChangeTypes = true
+	linters = append(linters, changesTypes, linter.NewConfig(fakeLinter{name: linter.LastLinter}))
+
+	names := func(lcs []*linter.Config) []string {
+		var ret []string
+		for _, lc := range lcs {
+			ret = append(ret, lc.Name())
+		}
+		return ret
+	}
+
+	shuffled := shuffleLinters(linters, 1)
+
+	// the same seed, the same order.
+	assert.Equal(t, names(shuffled), names(shuffleLinters(linters, 1)))
+	assert.ElementsMatch(t, names(linters), names(shuffled))
+	assert.NotEqual(t, names(linters), names(shuffled))
+
+	assert.Equal(t, []string{"unused", linter.LastLinter}, names(shuffled)[len(shuffled)-2:])
+}
@@ -0,0 +1,34 @@
+package logutils
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/golangci/golangci-lint/pkg/exitcodes"
+)
+
+// DiscardLog is a log discarding the messages, except that Fatalf and Panicf still stop the program:
+// e.g. for the timings of the stopwatches disabled with --no-timing.
+type DiscardLog struct{}
+
+var _ Log = DiscardLog{}
+
+func NewDiscardLog() DiscardLog {
+	return DiscardLog{}
+}
+
+func (DiscardLog) Fatalf(format string, args ...interface{}) {
+	fmt.Fprintln(StdErr, fmt.Sprintf(format, args...))
+	os.Exit(exitcodes.Failure)
+}
+
+func (DiscardLog) Panicf(format string, args ...interface{}) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+func (DiscardLog) Errorf(string, ...interface{}) {}
+func (DiscardLog) Warnf(string, ...interface{})  {}
+func (DiscardLog) Infof(string, ...interface{})  {}
+
+func (l DiscardLog) Child(string) Log { return l }
+func (DiscardLog) SetLevel(LogLevel)  {}
@@ -15,30 +15,54 @@ const envDebug = "GL_DEBUG"
 const (
 	DebugKeyAutogenExclude     = "autogen_exclude"
 	DebugKeyBinSalt            = "bin_salt"
+	DebugKeyBlameAuthors       = "blame_authors"
+	DebugKeyBlameNewerThan     = "blame_newer_than"
+	DebugKeyCompilerDiags      = "compiler_diagnostics"
 	DebugKeyConfigReader       = "config_reader"
+	DebugKeyDiffByFunction     = "diff_by_function"
 	DebugKeyEmpty              = ""
 	DebugKeyEnabledLinters     = "enabled_linters"
+	DebugKeyEnclosingFunc      = "enclosing_func"
 	DebugKeyEnv                = "env"
 	DebugKeyExcludeRules       = "exclude_rules"
 	DebugKeyExec               = "exec"
+	DebugKeyFileModified       = "file_modified"
 	DebugKeyFilenameUnadjuster = "filename_unadjuster"
+	DebugKeyFingerprintContext = "fingerprint_context"
+	DebugKeyFirstSeen          = "first_seen"
 	DebugKeyGoEnv              = "goenv"
+	DebugKeyIncludeOffsets     = "include_offsets"
+	DebugKeyInlineGenerated    = "inline_generated"
 	DebugKeyLinter             = "linter"
 	DebugKeyLintersContext     = "linters_context"
 	DebugKeyLintersDB          = "lintersdb"
 	DebugKeyLintersOutput      = "linters_output"
 	DebugKeyLoader             = "loader"
+	DebugKeyMaxDistinctLinters = "max_distinct_linters"
+	DebugKeyMaxFiles           = "max_files"
 	DebugKeyMaxFromLinter      = "max_from_linter"
 	DebugKeyMaxSameIssues      = "max_same_issues"
+	DebugKeyMaxTotal           = "max_total"
+	DebugKeyNoopFixes          = "noop_fixes"
+	DebugKeyOnlyTrackedFiles   = "only_tracked_files"
+	DebugKeyPackageBudget      = "package_budget"
+	DebugKeyPathBase           = "path_base"
 	DebugKeyPkgCache           = "pkgcache"
+	DebugKeyReportThreshold    = "report_threshold"
 	DebugKeyRunner             = "runner"
 	DebugKeySeverityRules      = "severity_rules"
+	DebugKeySidecarAllowlist   = "sidecar_allowlist"
 	DebugKeySkipDirs           = "skip_dirs"
+	DebugKeySkipLargeFiles     = "skip_large_files"
+	DebugKeySkipVendor         = "skip_vendor"
 	DebugKeySourceCode         = "source_code"
 	DebugKeyStopwatch          = "stopwatch"
 	DebugKeyTabPrinter         = "tab_printer"
 	DebugKeyTest               = "test"
 	DebugKeyTextPrinter        = "text_printer"
+	DebugKeyTriagedIssues      = "triaged_issues"
+	DebugKeyTypecheckCollapse  = "typecheck_collapse"
+	DebugKeyValidatePositions  = "validate_positions"
 )
 
 const (
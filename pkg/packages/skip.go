@@ -15,6 +15,24 @@ func pathElemRe(e string) string {
 	return pathElemReImpl(e, filepath.Separator)
 }
 
+// StdExcludeDir is a directory skipped by default, identified by its name.
+type StdExcludeDir struct {
+	Name   string
+	Regexp string
+}
+
+var StdExcludeDirs = []StdExcludeDir{
+	{Name: "vendor", Regexp: pathElemRe("vendor")},
+	{Name: "third_party", Regexp: pathElemRe("third_party")},
+	{Name: "testdata", Regexp: pathElemRe("testdata")},
+	{Name: "examples", Regexp: pathElemRe("examples")},
+	{Name: "Godeps", Regexp: pathElemRe("Godeps")},
+	{Name: "builtin", Regexp: pathElemRe("builtin")},
+}
+
+// StdExcludeDirRegexps are the regexps of all the default skipped directories.
+//
+// Deprecated: use GetStdExcludeDirRegexps, taking into account the re-enabled default skipped directories.
 var StdExcludeDirRegexps = []string{
 	pathElemRe("vendor"),
 	pathElemRe("third_party"),
@@ -23,3 +41,29 @@ var StdExcludeDirRegexps = []string{
 	pathElemRe("Godeps"),
 	pathElemRe("builtin"),
 }
+
+// GetStdExcludeDirRegexps returns the regexps of the default skipped directories,
+// except the disabled ones (by name).
+func GetStdExcludeDirRegexps(disabled []string) ([]string, error) {
+	disabledMap := make(map[string]bool, len(disabled))
+	for _, name := range disabled {
+		disabledMap[name] = true
+	}
+
+	var ret []string
+	for _, dir := range StdExcludeDirs {
+		if disabledMap[dir.Name] {
+			delete(disabledMap, dir.Name)
+			continue
+		}
+		ret = append(ret, dir.Regexp)
+	}
+
+	for _, name := range disabled {
+		if disabledMap[name] {
+			return nil, fmt.Errorf("unknown default skip dir %q", name)
+		}
+	}
+
+	return ret, nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPathElemRe(t *testing.T) {
@@ -36,3 +37,21 @@ func TestPathElemRe(t *testing.T) {
 		}
 	}
 }
+
+func TestGetStdExcludeDirRegexps(t *testing.T) {
+	all, err := GetStdExcludeDirRegexps(nil)
+	require.NoError(t, err)
+	assert.Equal(t, StdExcludeDirRegexps, all)
+
+	regexps, err := GetStdExcludeDirRegexps([]string{"testdata", "Godeps"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		pathElemRe("vendor"),
+		pathElemRe("third_party"),
+		pathElemRe("examples"),
+		pathElemRe("builtin"),
+	}, regexps)
+
+	_, err = GetStdExcludeDirRegexps([]string{"testdata", "unknown"})
+	assert.EqualError(t, err, `unknown default skip dir "unknown"`)
+}
@@ -0,0 +1,39 @@
+package printers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Grep struct {
+	w io.Writer
+}
+
+// NewGrep output format outputs exactly one line per issue: `path:line:col: [linter] message`.
+// It never uses colors nor adds any decoration, whatever the terminal is,
+// so the output can be safely piped into editors and scripts.
+func NewGrep(w io.Writer) *Grep {
+	return &Grep{w: w}
+}
+
+func (p *Grep) Print(_ context.Context, issues []result.Issue) error {
+	for ind := range issues {
+		_, err := fmt.Fprintln(p.w, formatIssueAsGrep(&issues[ind]))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatIssueAsGrep formats the issue on a single line: the column is 0 when it's unknown.
+func formatIssueAsGrep(issue *result.Issue) string {
+	// multi-line messages are joined to keep one line per issue.
+	text := strings.Join(strings.Fields(issue.Text), " ")
+
+	return fmt.Sprintf("%s:%d:%d: [%s] %s", issue.FilePath(), issue.Line(), issue.Column(), issue.FromLinter, text)
+}
@@ -0,0 +1,56 @@
+package printers
+
+import (
+	"bytes"
+	"context"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestGrep_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "error",
+			Text:       "another issue\n\ton several lines",
+			SourceLines: []string{
+				"func foo() {",
+				"\tfmt.Println(\"bar\")",
+				"}",
+			},
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	printer := NewGrep(buf)
+
+	err := printer.Print(context.Background(), issues)
+	require.NoError(t, err)
+
+	expected := `path/to/filea.go:10:4: [linter-a] some issue
+path/to/fileb.go:300:0: [linter-b] another issue on several lines
+`
+
+	assert.Equal(t, expected, buf.String())
+}
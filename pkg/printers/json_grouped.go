@@ -0,0 +1,31 @@
+package printers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type JSONGrouped struct {
+	w io.Writer
+}
+
+// NewJSONGrouped output format outputs the issues nested by file path:
+// `{"files":{"path":[issues...]}}`, the issues of each file sorted by line.
+func NewJSONGrouped(w io.Writer) *JSONGrouped {
+	return &JSONGrouped{w: w}
+}
+
+type JSONGroupedResult struct {
+	Files map[string][]result.Issue `json:"files"`
+}
+
+func (p JSONGrouped) Print(ctx context.Context, issues []result.Issue) error {
+	res := JSONGroupedResult{
+		Files: groupIssuesByFile(issues),
+	}
+
+	return json.NewEncoder(p.w).Encode(res)
+}
@@ -0,0 +1,57 @@
+package printers
+
+import (
+	"bytes"
+	"context"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestJSONGrouped_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "later issue",
+			Pos:        token.Position{Filename: "path/to/filea.go", Line: 10, Column: 4},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "error",
+			Text:       "other file issue",
+			Pos:        token.Position{Filename: "path/to/fileb.go", Line: 3, Column: 1},
+		},
+		{
+			FromLinter: "linter-b",
+			Text:       "first issue",
+			Pos:        token.Position{Filename: "path/to/filea.go", Line: 2, Column: 9},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewJSONGrouped(buf)
+
+	err := printer.Print(context.Background(), issues)
+	require.NoError(t, err)
+
+	//nolint:lll
+	expected := `{"files":{"path/to/filea.go":[{"FromLinter":"linter-b","Text":"first issue","Severity":"","SourceLines":null,"Replacement":null,"AutoFixable":false,"Pos":{"Filename":"path/to/filea.go","Offset":0,"Line":2,"Column":9},"ExpectNoLint":false,"ExpectedNoLintLinter":""},{"FromLinter":"linter-a","Text":"later issue","Severity":"warning","SourceLines":null,"Replacement":null,"AutoFixable":false,"Pos":{"Filename":"path/to/filea.go","Offset":0,"Line":10,"Column":4},"ExpectNoLint":false,"ExpectedNoLintLinter":""}],"path/to/fileb.go":[{"FromLinter":"linter-b","Text":"other file issue","Severity":"error","SourceLines":null,"Replacement":null,"AutoFixable":false,"Pos":{"Filename":"path/to/fileb.go","Offset":0,"Line":3,"Column":1},"ExpectNoLint":false,"ExpectedNoLintLinter":""}]}}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestJSONGrouped_Print_empty(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	err := NewJSONGrouped(buf).Print(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "{\"files\":{}}\n", buf.String())
+}
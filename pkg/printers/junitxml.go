@@ -38,27 +38,41 @@ type failureXML struct {
 }
 
 type JunitXML struct {
-	w io.Writer
+	w             io.Writer
+	groupByLinter bool
 }
 
+// NewJunitXML output format outputs a test suite per file, with a failed test case per issue.
 func NewJunitXML(w io.Writer) *JunitXML {
 	return &JunitXML{w: w}
 }
 
+// NewJunitXMLByLinter output format outputs a test suite per linter, with a failed test case per issue
+// named by the position of the issue.
+func NewJunitXMLByLinter(w io.Writer) *JunitXML {
+	return &JunitXML{w: w, groupByLinter: true}
+}
+
 func (p JunitXML) Print(ctx context.Context, issues []result.Issue) error {
-	suites := make(map[string]testSuiteXML) // use a map to group by file
+	suites := make(map[string]testSuiteXML) // use a map to group by file or by linter
 
 	for ind := range issues {
 		i := &issues[ind]
 		suiteName := i.FilePath()
+		caseName, className := i.FromLinter, i.Pos.String()
+		if p.groupByLinter {
+			suiteName = i.FromLinter
+			caseName, className = i.Pos.String(), i.FromLinter
+		}
+
 		testSuite := suites[suiteName]
-		testSuite.Suite = i.FilePath()
+		testSuite.Suite = suiteName
 		testSuite.Tests++
 		testSuite.Failures++
 
 		tc := testCaseXML{
-			Name:      i.FromLinter,
-			ClassName: i.Pos.String(),
+			Name:      caseName,
+			ClassName: className,
 			Failure: failureXML{
 				Type:    i.Severity,
 				Message: i.Pos.String() + ": " + i.Text,
@@ -75,3 +75,71 @@ Details: func foo() {
 
 	assert.Equal(t, expected, buf.String())
 }
+
+func TestJunitXMLByLinter_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-b",
+			Severity:   "error",
+			Text:       "issue with <markup> & \"quotes\"",
+			Pos:        token.Position{Filename: "path/to/filea.go", Line: 10, Column: 4},
+		},
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue",
+			Pos:        token.Position{Filename: "path/to/fileb.go", Line: 3, Column: 1},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "error",
+			Text:       "another issue",
+			Pos:        token.Position{Filename: "path/to/fileb.go", Line: 300, Column: 9},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	printer := NewJunitXMLByLinter(buf)
+
+	err := printer.Print(context.Background(), issues)
+	require.NoError(t, err)
+
+	expected := `<testsuites>
+  <testsuite name="linter-a" tests="1" errors="0" failures="1">
+    <testcase name="path/to/fileb.go:3:1" classname="linter-a">
+      <failure message="path/to/fileb.go:3:1: some issue" type="warning"><![CDATA[warning: some issue
+Category: linter-a
+File: path/to/fileb.go
+Line: 3
+Details: ]]></failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="linter-b" tests="2" errors="0" failures="2">
+    <testcase name="path/to/filea.go:10:4" classname="linter-b">
+      <failure message="path/to/filea.go:10:4: issue with &lt;markup&gt; &amp; &#34;quotes&#34;" type="error"><![CDATA[error: issue with <markup> & "quotes"
+Category: linter-b
+File: path/to/filea.go
+Line: 10
+Details: ]]></failure>
+    </testcase>
+    <testcase name="path/to/fileb.go:300:9" classname="linter-b">
+      <failure message="path/to/fileb.go:300:9: another issue" type="error"><![CDATA[error: another issue
+Category: linter-b
+File: path/to/fileb.go
+Line: 300
+Details: ]]></failure>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestJunitXML_Print_empty(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	err := NewJunitXMLByLinter(buf).Print(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "<testsuites></testsuites>", buf.String())
+}
@@ -0,0 +1,56 @@
+package printers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const (
+	ndjsonTypeIssue   = "issue"
+	ndjsonTypeSummary = "summary"
+)
+
+type NDJSON struct {
+	w io.Writer
+}
+
+// NewNDJSON output format outputs one JSON object per line: one line per issue,
+// then a final summary line with the total count of the issues and the count per linter.
+// Every line has a `type` field (`issue` or `summary`) to distinguish them.
+func NewNDJSON(w io.Writer) *NDJSON {
+	return &NDJSON{w: w}
+}
+
+type ndjsonIssue struct {
+	Type string `json:"type"`
+	result.Issue
+}
+
+type ndjsonSummary struct {
+	Type     string         `json:"type"`
+	Total    int            `json:"total"`
+	ByLinter map[string]int `json:"byLinter"`
+}
+
+func (p *NDJSON) Print(_ context.Context, issues []result.Issue) error {
+	enc := json.NewEncoder(p.w)
+
+	summary := ndjsonSummary{
+		Type:     ndjsonTypeSummary,
+		Total:    len(issues),
+		ByLinter: map[string]int{},
+	}
+
+	for ind := range issues {
+		if err := enc.Encode(ndjsonIssue{Type: ndjsonTypeIssue, Issue: issues[ind]}); err != nil {
+			return err
+		}
+
+		summary.ByLinter[issues[ind].FromLinter]++
+	}
+
+	return enc.Encode(summary)
+}
@@ -0,0 +1,73 @@
+package printers
+
+import (
+	"bytes"
+	"context"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestNDJSON_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "error",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+		},
+		{
+			FromLinter: "linter-a",
+			Text:       "third issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Line:     12,
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	printer := NewNDJSON(buf)
+
+	err := printer.Print(context.Background(), issues)
+	require.NoError(t, err)
+
+	//nolint:lll
+	expected := `{"type":"issue","FromLinter":"linter-a","Text":"some issue","Severity":"warning","SourceLines":null,"Replacement":null,"AutoFixable":false,"Pos":{"Filename":"path/to/filea.go","Offset":2,"Line":10,"Column":4},"ExpectNoLint":false,"ExpectedNoLintLinter":""}
+{"type":"issue","FromLinter":"linter-b","Text":"another issue","Severity":"error","SourceLines":null,"Replacement":null,"AutoFixable":false,"Pos":{"Filename":"path/to/fileb.go","Offset":5,"Line":300,"Column":9},"ExpectNoLint":false,"ExpectedNoLintLinter":""}
+{"type":"issue","FromLinter":"linter-a","Text":"third issue","Severity":"","SourceLines":null,"Replacement":null,"AutoFixable":false,"Pos":{"Filename":"path/to/filea.go","Offset":0,"Line":12,"Column":0},"ExpectNoLint":false,"ExpectedNoLintLinter":""}
+{"type":"summary","total":3,"byLinter":{"linter-a":2,"linter-b":1}}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestNDJSON_Print_NoIssues(t *testing.T) {
+	buf := new(bytes.Buffer)
+	printer := NewNDJSON(buf)
+
+	err := printer.Print(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"type":"summary","total":0,"byLinter":{}}`+"\n", buf.String())
+}
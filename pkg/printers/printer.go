@@ -2,6 +2,7 @@ package printers
 
 import (
 	"context"
+	"sort"
 
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -9,3 +10,24 @@ import (
 type Printer interface {
 	Print(ctx context.Context, issues []result.Issue) error
 }
+
+// groupIssuesByFile returns the issues grouped by file path, the issues of each file sorted by line and column.
+func groupIssuesByFile(issues []result.Issue) map[string][]result.Issue {
+	files := map[string][]result.Issue{}
+	for ind := range issues {
+		path := issues[ind].FilePath()
+		files[path] = append(files[path], issues[ind])
+	}
+
+	for _, fileIssues := range files {
+		fileIssues := fileIssues
+		sort.SliceStable(fileIssues, func(i, j int) bool {
+			if fileIssues[i].Line() != fileIssues[j].Line() {
+				return fileIssues[i].Line() < fileIssues[j].Line()
+			}
+			return fileIssues[i].Column() < fileIssues[j].Column()
+		})
+	}
+
+	return files
+}
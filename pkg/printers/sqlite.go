@@ -0,0 +1,101 @@
+package printers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	// The pure Go "sqlite" database/sql driver: the releases are built with CGO_ENABLED=0,
+	// the cgo drivers (e.g. mattn/go-sqlite3) can't be used. Its dependencies are the ones of its transpiled C code.
+	_ "modernc.org/sqlite"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// sqliteBusyTimeout is how long a writer waits for a lock held by another process (e.g. a concurrent CI job).
+const sqliteBusyTimeout = 30 * time.Second
+
+const sqliteSchema = `CREATE TABLE IF NOT EXISTS issues (
+	run_at      TEXT    NOT NULL,
+	linter      TEXT    NOT NULL,
+	path        TEXT    NOT NULL,
+	line        INTEGER NOT NULL,
+	severity    TEXT    NOT NULL,
+	fingerprint TEXT    NOT NULL
+)`
+
+// SQLite appends issues to a SQLite database file.
+// Each run is inserted in one transaction, all rows of a run share the same timestamp.
+type SQLite struct {
+	path string
+	now  func() time.Time
+}
+
+func NewSQLite(path string) *SQLite {
+	return &SQLite{
+		path: path,
+		now:  time.Now,
+	}
+}
+
+func (p SQLite) Print(ctx context.Context, issues []result.Issue) error {
+	db, err := sql.Open("sqlite", p.path)
+	if err != nil {
+		return fmt.Errorf("can't open sqlite database %s: %w", p.path, err)
+	}
+	defer db.Close()
+
+	// The pragmas are per connection: use only one.
+	db.SetMaxOpenConns(1)
+
+	pragmas := []string{
+		fmt.Sprintf("PRAGMA busy_timeout = %d", sqliteBusyTimeout.Milliseconds()),
+		// WAL mode to allow concurrent readers and serialized writers without corrupting the file.
+		"PRAGMA journal_mode = WAL",
+	}
+	for _, pragma := range pragmas {
+		if _, err = db.ExecContext(ctx, pragma); err != nil {
+			return fmt.Errorf("can't set up sqlite database %s (%s): %w", p.path, pragma, err)
+		}
+	}
+
+	if _, err = db.ExecContext(ctx, sqliteSchema); err != nil {
+		return fmt.Errorf("can't create sqlite schema in %s: %w", p.path, err)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("can't begin sqlite transaction in %s: %w", p.path, err)
+	}
+
+	if err = insertSQLiteIssues(ctx, tx, p.now().UTC().Format(time.RFC3339), issues); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("can't commit sqlite transaction in %s: %w", p.path, err)
+	}
+
+	return nil
+}
+
+func insertSQLiteIssues(ctx context.Context, tx *sql.Tx, runAt string, issues []result.Issue) error {
+	stmt, err := tx.PrepareContext(ctx,
+		"INSERT INTO issues (run_at, linter, path, line, severity, fingerprint) VALUES (?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return fmt.Errorf("can't prepare sqlite statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for ind := range issues {
+		i := &issues[ind]
+		_, err = stmt.ExecContext(ctx, runAt, i.FromLinter, i.FilePath(), i.Line(), i.Severity, i.Fingerprint())
+		if err != nil {
+			return fmt.Errorf("can't insert issue %s:%d into sqlite database: %w", i.FilePath(), i.Line(), err)
+		}
+	}
+
+	return nil
+}
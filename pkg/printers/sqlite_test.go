@@ -0,0 +1,80 @@
+package printers
+
+import (
+	"context"
+	"database/sql"
+	"go/token"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestSQLite_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "error",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+		},
+	}
+
+	dbPath := filepath.Join(t.TempDir(), "results.db")
+
+	printer := NewSQLite(dbPath)
+	printer.now = func() time.Time { return time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC) }
+
+	// the second run appends to the existing table.
+	for i := 0; i < 2; i++ {
+		err := printer.Print(context.Background(), issues)
+		require.NoError(t, err)
+	}
+
+	db, err := sql.Open("sqlite", dbPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	rows, err := db.Query("SELECT run_at, linter, path, line, severity, fingerprint FROM issues")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = rows.Close() })
+
+	type row struct {
+		runAt, linter, path string
+		line                int
+		severity, fp        string
+	}
+
+	var got []row
+	for rows.Next() {
+		var r row
+		require.NoError(t, rows.Scan(&r.runAt, &r.linter, &r.path, &r.line, &r.severity, &r.fp))
+		got = append(got, r)
+	}
+	require.NoError(t, rows.Err())
+
+	first := row{"2022-12-01T10:00:00Z", "linter-a", "path/to/filea.go", 10, "warning", issues[0].Fingerprint()}
+	second := row{"2022-12-01T10:00:00Z", "linter-b", "path/to/fileb.go", 300, "error", issues[1].Fingerprint()}
+
+	assert.Equal(t, []row{first, second, first, second}, got)
+}
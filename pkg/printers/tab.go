@@ -14,13 +14,15 @@ import (
 
 type Tab struct {
 	printLinterName bool
+	printPackage    bool
 	log             logutils.Log
 	w               io.Writer
 }
 
-func NewTab(printLinterName bool, log logutils.Log, w io.Writer) *Tab {
+func NewTab(printLinterName, printPackage bool, log logutils.Log, w io.Writer) *Tab {
 	return &Tab{
 		printLinterName: printLinterName,
+		printPackage:    printPackage,
 		log:             log,
 		w:               w,
 	}
@@ -56,5 +58,10 @@ func (p Tab) printIssue(i *result.Issue, w io.Writer) {
 		pos += fmt.Sprintf(":%d", i.Pos.Column)
 	}
 
+	if p.printPackage {
+		// the package is a column (empty if unknown): the columns stay aligned.
+		pos = fmt.Sprintf("%s\t%s", i.PackagePath, pos)
+	}
+
 	fmt.Fprintf(w, "%s\t%s\n", pos, text)
 }
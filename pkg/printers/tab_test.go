@@ -46,7 +46,7 @@ func TestTab_Print(t *testing.T) {
 
 	buf := new(bytes.Buffer)
 
-	printer := NewTab(true, logutils.NewStderrLog(logutils.DebugKeyEmpty), buf)
+	printer := NewTab(true, false, logutils.NewStderrLog(logutils.DebugKeyEmpty), buf)
 
 	err := printer.Print(context.Background(), issues)
 	require.NoError(t, err)
@@ -57,3 +57,40 @@ path/to/fileb.go:300:9  linter-b  another issue
 
 	assert.Equal(t, expected, buf.String())
 }
+
+func TestTab_Print_showPackage(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter:  "linter-a",
+			Text:        "some issue",
+			PackagePath: "github.com/org/repo/path/to",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Line:     300,
+				Column:   9,
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewTab(true, true, logutils.NewStderrLog(logutils.DebugKeyEmpty), buf)
+
+	err := printer.Print(context.Background(), issues)
+	require.NoError(t, err)
+
+	expected := `github.com/org/repo/path/to  path/to/filea.go:10:4   linter-a  some issue
+                             path/to/fileb.go:300:9  linter-b  another issue
+`
+
+	assert.Equal(t, expected, buf.String())
+}
@@ -16,16 +16,20 @@ type Text struct {
 	printIssuedLine bool
 	useColors       bool
 	printLinterName bool
+	printPackage    bool
+	printIndex      bool
 
 	log logutils.Log
 	w   io.Writer
 }
 
-func NewText(printIssuedLine, useColors, printLinterName bool, log logutils.Log, w io.Writer) *Text {
+func NewText(printIssuedLine, useColors, printLinterName, printPackage, printIndex bool, log logutils.Log, w io.Writer) *Text {
 	return &Text{
 		printIssuedLine: printIssuedLine,
 		useColors:       useColors,
 		printLinterName: printLinterName,
+		printPackage:    printPackage,
+		printIndex:      printIndex,
 		log:             log,
 		w:               w,
 	}
@@ -64,6 +68,12 @@ func (p Text) printIssue(i *result.Issue) {
 	if i.Pos.Column != 0 {
 		pos += fmt.Sprintf(":%d", i.Pos.Column)
 	}
+	if p.printPackage && i.PackagePath != "" {
+		pos = fmt.Sprintf("[%s] %s", i.PackagePath, pos)
+	}
+	if p.printIndex && i.Index != 0 {
+		pos = fmt.Sprintf("#%d %s", i.Index, pos)
+	}
 	fmt.Fprintf(p.w, "%s: %s\n", pos, text)
 }
 
@@ -46,7 +46,7 @@ func TestText_Print(t *testing.T) {
 
 	buf := new(bytes.Buffer)
 
-	printer := NewText(true, false, true, logutils.NewStderrLog(logutils.DebugKeyEmpty), buf)
+	printer := NewText(true, false, true, false, false, logutils.NewStderrLog(logutils.DebugKeyEmpty), buf)
 
 	err := printer.Print(context.Background(), issues)
 	require.NoError(t, err)
@@ -60,3 +60,76 @@ func foo() {
 
 	assert.Equal(t, expected, buf.String())
 }
+
+func TestText_Print_showPackage(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter:  "linter-a",
+			Text:        "some issue",
+			PackagePath: "github.com/org/repo/path/to",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Line:     300,
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewText(false, false, true, true, false, logutils.NewStderrLog(logutils.DebugKeyEmpty), buf)
+
+	err := printer.Print(context.Background(), issues)
+	require.NoError(t, err)
+
+	expected := `[github.com/org/repo/path/to] path/to/filea.go:10:4: some issue (linter-a)
+path/to/fileb.go:300: another issue (linter-b)
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestTextPrinter_PrintIndex(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Text:       "some issue",
+			Index:      1,
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Text:       "another issue",
+			Index:      2,
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Line:     300,
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewText(false, false, true, false, true, logutils.NewStderrLog(logutils.DebugKeyEmpty), buf)
+
+	err := printer.Print(context.Background(), issues)
+	require.NoError(t, err)
+
+	expected := `#1 path/to/filea.go:10:4: some issue (linter-a)
+#2 path/to/fileb.go:300: another issue (linter-b)
+`
+
+	assert.Equal(t, expected, buf.String())
+}
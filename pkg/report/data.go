@@ -1,5 +1,14 @@
 package report
 
+import (
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// UngroupedLinterGroup is the group of the issues of the linters in no group.
+const UngroupedLinterGroup = "ungrouped"
+
 type Warning struct {
 	Tag  string `json:",omitempty"`
 	Text string
@@ -11,10 +20,32 @@ type LinterData struct {
 	EnabledByDefault bool `json:",omitempty"`
 }
 
+type LinterGroupData struct {
+	Name        string
+	IssuesCount int
+}
+
+// LinterStatusesData are the sorted names of the linters which ran, by outcome.
+type LinterStatusesData struct {
+	Issues  []string `json:",omitempty"` // the linters with reported issues
+	Clean   []string `json:",omitempty"` // the linters without reported issues
+	Errored []string `json:",omitempty"`
+	Skipped []string `json:",omitempty"` // the enabled linters which didn't run, e.g. needing type info on loose files
+}
+
+// IssuesLimitData is set when the issues.max-total limit was reached.
+type IssuesLimitData struct {
+	Limit int // the count of reported issues
+	Total int // the count of issues before the truncation
+}
+
 type Data struct {
-	Warnings []Warning    `json:",omitempty"`
-	Linters  []LinterData `json:",omitempty"`
-	Error    string       `json:",omitempty"`
+	Warnings       []Warning           `json:",omitempty"`
+	Linters        []LinterData        `json:",omitempty"`
+	LinterGroups   []LinterGroupData   `json:",omitempty"`
+	LinterStatuses *LinterStatusesData `json:",omitempty"`
+	IssuesLimit    *IssuesLimitData    `json:",omitempty"`
+	Error          string              `json:",omitempty"`
 }
 
 func (d *Data) AddLinter(name string, enabled, enabledByDefault bool) {
@@ -24,3 +55,40 @@ func (d *Data) AddLinter(name string, enabled, enabledByDefault bool) {
 		EnabledByDefault: enabledByDefault,
 	})
 }
+
+// SetLinterGroups sets the issues counts of the linter groups (group name to linter names):
+// an issue is counted in each group of its linter, or in the ungrouped group.
+// The groups are sorted by name, the ungrouped group is the last one.
+func (d *Data) SetLinterGroups(groups map[string][]string, issues []result.Issue) {
+	linterGroups := map[string][]string{}
+	counts := map[string]int{}
+	for name, linters := range groups {
+		counts[name] = 0
+		for _, linter := range linters {
+			linterGroups[linter] = append(linterGroups[linter], name)
+		}
+	}
+
+	var ungroupedCount int
+	for i := range issues {
+		names, ok := linterGroups[issues[i].FromLinter]
+		if !ok {
+			ungroupedCount++
+			continue
+		}
+
+		for _, name := range names {
+			counts[name]++
+		}
+	}
+
+	d.LinterGroups = make([]LinterGroupData, 0, len(counts)+1)
+	for name, count := range counts {
+		d.LinterGroups = append(d.LinterGroups, LinterGroupData{Name: name, IssuesCount: count})
+	}
+	sort.Slice(d.LinterGroups, func(i, j int) bool {
+		return d.LinterGroups[i].Name < d.LinterGroups[j].Name
+	})
+
+	d.LinterGroups = append(d.LinterGroups, LinterGroupData{Name: UngroupedLinterGroup, IssuesCount: ungroupedCount})
+}
@@ -0,0 +1,38 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestData_SetLinterGroups(t *testing.T) {
+	groups := map[string][]string{
+		"security": {"gosec"},
+		"style":    {"revive", "stylecheck"},
+		"bugs":     {"govet", "gosec"},
+		"empty":    {"dupl"},
+	}
+
+	issues := []result.Issue{
+		{FromLinter: "gosec"},
+		{FromLinter: "revive"},
+		{FromLinter: "stylecheck"},
+		{FromLinter: "errcheck"},
+		{FromLinter: "gosec"},
+	}
+
+	d := &Data{}
+	d.SetLinterGroups(groups, issues)
+
+	expected := []LinterGroupData{
+		{Name: "bugs", IssuesCount: 2},
+		{Name: "empty", IssuesCount: 0},
+		{Name: "security", IssuesCount: 2},
+		{Name: "style", IssuesCount: 2},
+		{Name: UngroupedLinterGroup, IssuesCount: 1},
+	}
+	assert.Equal(t, expected, d.LinterGroups)
+}
@@ -0,0 +1,86 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// The names of the metrics of WritePrometheusMetrics: they are stable, the scrapers depend on them.
+const (
+	MetricIssues             = "golangci_lint_issues"
+	MetricLinterIssues       = "golangci_lint_linter_issues"
+	MetricLinterDuration     = "golangci_lint_linter_duration_seconds"
+	MetricGoAnalysisDuration = "golangci_lint_goanalysis_duration_seconds"
+	MetricRunDuration        = "golangci_lint_run_duration_seconds"
+)
+
+// prometheusLabelLinterName is the label of the metrics by linter.
+const prometheusLabelLinterName = "linter"
+
+var prometheusLabelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// WritePrometheusMetrics writes the metrics of the run in the Prometheus text format, as gauges:
+// the count of the reported issues, in total and by linter (0 for the enabled linters without issues),
+// the durations of the linters, the duration of the combined go/analysis linters and the duration of the run.
+// The combined go/analysis linters run together: they aren't in linterDurations, and they have no linter label.
+func (d *Data) WritePrometheusMetrics(w io.Writer, issues []result.Issue,
+	linterDurations map[string]time.Duration, goAnalysisDuration, runDuration time.Duration) error {
+	counts := map[string]int{}
+	for _, linter := range d.Linters {
+		if linter.Enabled {
+			counts[linter.Name] = 0
+		}
+	}
+	for i := range issues {
+		counts[issues[i].FromLinter]++
+	}
+
+	var b strings.Builder
+
+	writePrometheusHeader(&b, MetricIssues, "Count of the reported issues.")
+	fmt.Fprintf(&b, "%s %d\n", MetricIssues, len(issues))
+
+	writePrometheusHeader(&b, MetricLinterIssues, "Count of the reported issues of the linter.")
+	for _, name := range sortedKeys(counts) {
+		fmt.Fprintf(&b, "%s{%s=\"%s\"} %d\n", MetricLinterIssues, prometheusLabelLinterName,
+			prometheusLabelReplacer.Replace(name), counts[name])
+	}
+
+	writePrometheusHeader(&b, MetricLinterDuration, "Duration of the run of the linter, in seconds.")
+	names := make([]string, 0, len(linterDurations))
+	for name := range linterDurations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s{%s=\"%s\"} %g\n", MetricLinterDuration, prometheusLabelLinterName,
+			prometheusLabelReplacer.Replace(name), linterDurations[name].Seconds())
+	}
+
+	writePrometheusHeader(&b, MetricGoAnalysisDuration, "Duration of the run of the combined go/analysis linters, in seconds.")
+	fmt.Fprintf(&b, "%s %g\n", MetricGoAnalysisDuration, goAnalysisDuration.Seconds())
+
+	writePrometheusHeader(&b, MetricRunDuration, "Duration of the run, in seconds.")
+	fmt.Fprintf(&b, "%s %g\n", MetricRunDuration, runDuration.Seconds())
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writePrometheusHeader(b *strings.Builder, name, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,54 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestData_WritePrometheusMetrics(t *testing.T) {
+	d := &Data{}
+	d.AddLinter("errcheck", true, true)
+	d.AddLinter("govet", true, true)
+	d.AddLinter("dupl", false, false)
+
+	issues := []result.Issue{
+		{FromLinter: "errcheck"},
+		{FromLinter: "errcheck"},
+		{FromLinter: "typecheck"},
+	}
+
+	durations := map[string]time.Duration{
+		"gofmt":     500 * time.Millisecond,
+		"typecheck": 250 * time.Millisecond,
+	}
+
+	var b strings.Builder
+	require.NoError(t, d.WritePrometheusMetrics(&b, issues, durations, 1500*time.Millisecond, 2*time.Second))
+
+	expected := `# HELP golangci_lint_issues Count of the reported issues.
+# TYPE golangci_lint_issues gauge
+golangci_lint_issues 3
+# HELP golangci_lint_linter_issues Count of the reported issues of the linter.
+# TYPE golangci_lint_linter_issues gauge
+golangci_lint_linter_issues{linter="errcheck"} 2
+golangci_lint_linter_issues{linter="govet"} 0
+golangci_lint_linter_issues{linter="typecheck"} 1
+# HELP golangci_lint_linter_duration_seconds Duration of the run of the linter, in seconds.
+# TYPE golangci_lint_linter_duration_seconds gauge
+golangci_lint_linter_duration_seconds{linter="gofmt"} 0.5
+golangci_lint_linter_duration_seconds{linter="typecheck"} 0.25
+# HELP golangci_lint_goanalysis_duration_seconds Duration of the run of the combined go/analysis linters, in seconds.
+# TYPE golangci_lint_goanalysis_duration_seconds gauge
+golangci_lint_goanalysis_duration_seconds 1.5
+# HELP golangci_lint_run_duration_seconds Duration of the run, in seconds.
+# TYPE golangci_lint_run_duration_seconds gauge
+golangci_lint_run_duration_seconds 2
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/md5" //nolint:gosec
 	"fmt"
 	"go/token"
+	"strings"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -12,6 +13,11 @@ type Range struct {
 	From, To int
 }
 
+// EndPosition is the end of the range of an issue, in the file of the issue.
+type EndPosition struct {
+	Line, Column int
+}
+
 type Replacement struct {
 	NeedOnlyDelete bool     // need to delete all lines of the issue without replacement with new lines
 	NewLines       []string // if NeedDelete is false it's the replacement lines
@@ -24,18 +30,37 @@ type InlineFix struct {
 	NewString string
 }
 
+// The types of the lines of the issues in a diff.
+const (
+	DiffLineTypeAdded     = "added"     // an added or modified line
+	DiffLineTypeContext   = "context"   // an unchanged line of a hunk
+	DiffLineTypeUnchanged = "unchanged" // an unchanged line outside the hunks, with whole-files
+)
+
 type Issue struct {
 	FromLinter string
 	Text       string
 
+	// Details are the continuation lines of a multi-line message, Text holds its first line
+	Details []string `json:",omitempty"`
+
 	Severity string
 
+	// SeverityReason is the origin of the severity set by the severity section, e.g. the matching rule or `default`
+	SeverityReason string `json:",omitempty"`
+
+	// CheckID is the identifier of the check of the linter reporting the issue, e.g. `SA1019` for staticcheck
+	CheckID string `json:",omitempty"`
+
 	// Source lines of a code with the issue to show
 	SourceLines []string
 
 	// If we know how to fix the issue we can provide replacement lines
 	Replacement *Replacement
 
+	// AutoFixable is whether the issue has a replacement to apply, set by the auto_fixable processor
+	AutoFixable bool
+
 	// Pkg is needed for proper caching of linting results
 	Pkg *packages.Package `json:"-"`
 
@@ -43,12 +68,52 @@ type Issue struct {
 
 	Pos token.Position
 
+	// EndPos is the end of the range of the issue, when the linter reports it
+	EndPos *EndPosition `json:",omitempty"`
+
+	// OriginalColumn is the column reported by the linter when Pos.Column is adjusted to the expanded tabs of SourceLines
+	OriginalColumn int `json:",omitempty"`
+
 	// HunkPos is used only when golangci-lint is run over a diff
 	HunkPos int `json:",omitempty"`
 
+	// DiffLineType is the type of the line of the issue in the diff (see issues.diff-annotate), e.g. DiffLineTypeAdded
+	DiffLineType string `json:",omitempty"`
+
+	// PackagePath is the import path of the package of the issue
+	PackagePath string `json:",omitempty"`
+
+	// ModulePath is the path of the module (from go.mod) owning the file of the issue
+	ModulePath string `json:",omitempty"`
+
+	// EnclosingFunc is the name of the function or method declaration enclosing the issue, e.g. `(*T).Method`
+	EnclosingFunc string `json:",omitempty"`
+
+	// Confidence is the score of the issue computed by issues.confidence-ranking, the higher the more likely real
+	Confidence int `json:",omitempty"`
+
+	// FirstSeen is the date (YYYY-MM-DD) when the fingerprint of the issue was first seen, see issues.first-seen-store
+	FirstSeen string `json:",omitempty"`
+
+	// Duplicates are the issues dropped in favor of this one by output.uniq-by-line, issues.dedup-test-variants
+	// and issues.dedup-symlinks
+	Duplicates []Issue `json:"-"`
+
+	// NotFailing is set on the issues outside the paths of issues.fail-on-paths: they don't set the exit code
+	NotFailing bool `json:"-"`
+
+	// Index is the 1-based position of the issue in the final output, assigned once the issues are sorted
+	Index int `json:",omitempty"`
+
 	// If we are expecting a nolint (because this is from nolintlint), record the expected linter
 	ExpectNoLint         bool
 	ExpectedNoLintLinter string
+
+	// FingerprintContext is the normalized code surrounding the issue, used by the fingerprint if set
+	FingerprintContext []string `json:"-"`
+
+	// fingerprint is the fingerprint fixed by FreezeFingerprint, "" if not fixed
+	fingerprint string
 }
 
 func (i *Issue) FilePath() string {
@@ -85,14 +150,45 @@ func (i *Issue) Description() string {
 	return fmt.Sprintf("%s: %s", i.FromLinter, i.Text)
 }
 
+// Fingerprint returns the hash of the file path, the text and the first source line of the issue,
+// or of the file path, the linter, the text and the surrounding code if FingerprintContext is set.
+// Once FreezeFingerprint is called, it's the hash of these at that time.
 func (i *Issue) Fingerprint() string {
+	if i.fingerprint != "" {
+		return i.fingerprint
+	}
+
+	hash := md5.New() //nolint:gosec
+
+	if i.FingerprintContext != nil {
+		_, _ = fmt.Fprintf(hash, "%s\x00%s\x00%s\x00%s", i.Pos.Filename, i.FromLinter, i.fullText(),
+			strings.Join(i.FingerprintContext, "\n"))
+
+		return fmt.Sprintf("%X", hash.Sum(nil))
+	}
+
 	firstLine := ""
 	if len(i.SourceLines) > 0 {
 		firstLine = i.SourceLines[0]
 	}
 
-	hash := md5.New() //nolint:gosec
-	_, _ = fmt.Fprintf(hash, "%s%s%s", i.Pos.Filename, i.Text, firstLine)
+	_, _ = fmt.Fprintf(hash, "%s%s%s", i.Pos.Filename, i.fullText(), firstLine)
 
 	return fmt.Sprintf("%X", hash.Sum(nil))
 }
+
+// FreezeFingerprint fixes the fingerprint to the current one:
+// the rewrites of the paths and the texts for the output (e.g. output.path-prefix) don't change it anymore.
+func (i *Issue) FreezeFingerprint() {
+	i.fingerprint = ""
+	i.fingerprint = i.Fingerprint()
+}
+
+// fullText returns the whole message, with the details: the fingerprint doesn't depend on the message split.
+func (i *Issue) fullText() string {
+	if len(i.Details) == 0 {
+		return i.Text
+	}
+
+	return i.Text + "\n" + strings.Join(i.Details, "\n")
+}
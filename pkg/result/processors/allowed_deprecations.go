@@ -0,0 +1,64 @@
+package processors
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// deprecationCheck is the staticcheck check of the uses of deprecated identifiers.
+const deprecationCheck = "SA1019"
+
+// deprecatedSymbolRe extracts the symbol of the staticcheck SA1019 messages:
+//   - "SA1019: ioutil.ReadFile has been deprecated since Go 1.16: As of Go 1.16, ..."
+//   - "SA1019: \"io/ioutil\" has been deprecated since Go 1.19: ..."
+//   - "SA1019: x.Old is deprecated: use New instead."
+var deprecatedSymbolRe = regexp.MustCompile(`^` + deprecationCheck + `: (.+?) (?:is|has been) deprecated\b`)
+
+// AllowedDeprecations drops the staticcheck SA1019 issues of the deprecated symbols knowingly still used,
+// e.g. during a migration: the deprecations of the other symbols are still reported.
+type AllowedDeprecations struct {
+	symbols map[string]bool
+}
+
+var _ Processor = AllowedDeprecations{}
+
+func NewAllowedDeprecations(symbols []string) *AllowedDeprecations {
+	p := &AllowedDeprecations{symbols: map[string]bool{}}
+	for _, symbol := range symbols {
+		p.symbols[strings.Trim(symbol, `"`)] = true
+	}
+	return p
+}
+
+func (p AllowedDeprecations) Name() string {
+	return "allowed_deprecations"
+}
+
+func (p AllowedDeprecations) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.symbols) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		if i.FromLinter != "staticcheck" {
+			return true
+		}
+
+		symbol, ok := parseDeprecatedSymbol(i.Text)
+		return !ok || !p.symbols[symbol]
+	}), nil
+}
+
+func (p AllowedDeprecations) Finish() {}
+
+// parseDeprecatedSymbol returns the deprecated symbol of a SA1019 message, unquoted for the packages.
+func parseDeprecatedSymbol(text string) (string, bool) {
+	m := deprecatedSymbolRe.FindStringSubmatch(text)
+	if m == nil {
+		return "", false
+	}
+
+	return strings.Trim(m[1], `"`), true
+}
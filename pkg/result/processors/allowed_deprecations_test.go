@@ -0,0 +1,60 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDeprecatedSymbol(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected string
+		ok       bool
+	}{
+		{
+			text:     "SA1019: ioutil.ReadFile has been deprecated since Go 1.16: As of Go 1.16, this function simply calls os.ReadFile.",
+			expected: "ioutil.ReadFile", ok: true,
+		},
+		{
+			text:     `SA1019: "io/ioutil" has been deprecated since Go 1.19: As of Go 1.16, the same functionality is now provided...`,
+			expected: "io/ioutil", ok: true,
+		},
+		{text: "SA1019: x.Old is deprecated: use New instead.", expected: "x.Old", ok: true},
+		{text: "SA1019: grpc.WithInsecure is deprecated: use WithTransportCredentials and insecure.NewCredentials() instead.",
+			expected: "grpc.WithInsecure", ok: true},
+		{text: "SA4006: this value of `err` is never used", ok: false},
+		{text: "x.Old is deprecated: use New instead.", ok: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.text, func(t *testing.T) {
+			symbol, ok := parseDeprecatedSymbol(tc.text)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, symbol)
+		})
+	}
+}
+
+func TestAllowedDeprecations(t *testing.T) {
+	p := NewAllowedDeprecations([]string{"ioutil.ReadFile", `"io/ioutil"`})
+
+	allowed := newIssueFromIssueTestCase(issueTestCase{Linter: "staticcheck",
+		Text: "SA1019: ioutil.ReadFile has been deprecated since Go 1.16: As of Go 1.16, this function simply calls os.ReadFile."})
+	allowedPkg := newIssueFromIssueTestCase(issueTestCase{Linter: "staticcheck",
+		Text: `SA1019: "io/ioutil" has been deprecated since Go 1.19: As of Go 1.16, the same functionality is now provided...`})
+	other := newIssueFromIssueTestCase(issueTestCase{Linter: "staticcheck",
+		Text: "SA1019: ioutil.WriteFile has been deprecated since Go 1.16: As of Go 1.16, this function simply calls os.WriteFile."})
+	otherCheck := newIssueFromIssueTestCase(issueTestCase{Linter: "staticcheck", Text: "SA4006: ioutil.ReadFile is deprecated"})
+	otherLinter := newIssueFromIssueTestCase(issueTestCase{Linter: "revive",
+		Text: "SA1019: ioutil.ReadFile has been deprecated since Go 1.16"})
+
+	processAssertEmpty(t, p, allowed, allowedPkg)
+	processAssertSame(t, p, other, otherCheck, otherLinter)
+}
+
+func TestAllowedDeprecationsEmpty(t *testing.T) {
+	processAssertSame(t, NewAllowedDeprecations(nil), newIssueFromIssueTestCase(issueTestCase{Linter: "staticcheck",
+		Text: "SA1019: ioutil.ReadFile has been deprecated since Go 1.16"}))
+}
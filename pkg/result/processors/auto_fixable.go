@@ -0,0 +1,34 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// AutoFixable marks the issues with a replacement as auto-fixable, e.g. for the editors to show a fix action.
+// The issues of the linters not suggesting fixes are not auto-fixable.
+type AutoFixable struct{}
+
+var _ Processor = AutoFixable{}
+
+func NewAutoFixable() *AutoFixable {
+	return &AutoFixable{}
+}
+
+func (p AutoFixable) Name() string {
+	return "auto_fixable"
+}
+
+func (p AutoFixable) Process(issues []result.Issue) ([]result.Issue, error) {
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		autoFixable := i.Replacement != nil
+		if i.AutoFixable == autoFixable {
+			return i
+		}
+
+		newI := *i
+		newI.AutoFixable = autoFixable
+		return &newI
+	}), nil
+}
+
+func (p AutoFixable) Finish() {}
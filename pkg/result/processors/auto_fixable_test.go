@@ -0,0 +1,25 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestAutoFixable(t *testing.T) {
+	p := NewAutoFixable()
+
+	withFix := result.Issue{Text: "with fix", Replacement: &result.Replacement{NeedOnlyDelete: true}}
+	withoutFix := result.Issue{Text: "without fix"}
+
+	processed := process(t, p, withFix, withoutFix)
+	require.Len(t, processed, 2)
+	assert.True(t, processed[0].AutoFixable)
+	assert.False(t, processed[1].AutoFixable)
+
+	// the input issues aren't modified
+	assert.False(t, withFix.AutoFixable)
+}
@@ -1,8 +1,10 @@
 package processors
 
 import (
+	"bufio"
 	"go/parser"
 	"go/token"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -20,14 +22,30 @@ type ageFileSummary struct {
 
 type ageFileSummaryCache map[string]*ageFileSummary
 
+// AutogeneratedExclude drops the issues of the generated files,
+// except the issues of the linters of the generated files linter set (e.g. license header checks).
+// The issues of the files which aren't Go source files (see goFileExtensions) are dropped.
 type AutogeneratedExclude struct {
 	fileSummaryCache ageFileSummaryCache
+
+	generatedFilesLinters map[string]bool
+
+	// goFileExtensions are the extensions of the Go source files besides `.go`, e.g. `.go.tmpl`.
+	goFileExtensions []string
 }
 
-func NewAutogeneratedExclude() *AutogeneratedExclude {
-	return &AutogeneratedExclude{
-		fileSummaryCache: ageFileSummaryCache{},
+func NewAutogeneratedExclude(generatedFilesLinters, goFileExtensions []string) *AutogeneratedExclude {
+	p := &AutogeneratedExclude{
+		fileSummaryCache:      ageFileSummaryCache{},
+		generatedFilesLinters: map[string]bool{},
+		goFileExtensions:      normalizeGoFileExtensions(goFileExtensions),
 	}
+
+	for _, linter := range generatedFilesLinters {
+		p.generatedFilesLinters[linter] = true
+	}
+
+	return p
 }
 
 var _ Processor = &AutogeneratedExclude{}
@@ -40,10 +58,43 @@ func (p *AutogeneratedExclude) Process(issues []result.Issue) ([]result.Issue, e
 	return filterIssuesErr(issues, p.shouldPassIssue)
 }
 
-func isSpecialAutogeneratedFile(filePath string) bool {
-	fileName := filepath.Base(filePath)
+func isSpecialAutogeneratedFile(filePath string, goFileExtensions []string) bool {
 	// fake files or generation definitions to which //line points to for generated files
-	return filepath.Ext(fileName) != ".go"
+	return !hasGoFileExtension(filePath, goFileExtensions)
+}
+
+// hasGoFileExtension reports whether the file has the `.go` extension or one of the extensions.
+func hasGoFileExtension(filePath string, goFileExtensions []string) bool {
+	fileName := filepath.Base(filePath)
+	if filepath.Ext(fileName) == goFileSuffix {
+		return true
+	}
+
+	for _, ext := range goFileExtensions {
+		if strings.HasSuffix(fileName, ext) && fileName != ext {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeGoFileExtensions adds the missing leading dots of the extensions, e.g. `go.tmpl` is `.go.tmpl`.
+func normalizeGoFileExtensions(goFileExtensions []string) []string {
+	var res []string
+	for _, ext := range goFileExtensions {
+		if ext == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+
+		res = append(res, ext)
+	}
+
+	return res
 }
 
 func (p *AutogeneratedExclude) shouldPassIssue(i *result.Issue) (bool, error) {
@@ -56,7 +107,12 @@ func (p *AutogeneratedExclude) shouldPassIssue(i *result.Issue) (bool, error) {
 		return true, nil
 	}
 
-	if isSpecialAutogeneratedFile(i.FilePath()) {
+	if p.generatedFilesLinters[i.FromLinter] {
+		// the linter runs on the generated files too.
+		return true, nil
+	}
+
+	if isSpecialAutogeneratedFile(i.FilePath(), p.goFileExtensions) {
 		return false, nil
 	}
 
@@ -106,6 +162,10 @@ func (p *AutogeneratedExclude) getOrCreateFileSummary(i *result.Issue) (*ageFile
 	}
 
 	doc, err := getDoc(i.FilePath())
+	if err != nil && filepath.Ext(i.FilePath()) != goFileSuffix {
+		// e.g. a template: its header is the text before its package clause.
+		doc, err = getHeader(i.FilePath())
+	}
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get doc of file %s", i.FilePath())
 	}
@@ -130,4 +190,32 @@ func getDoc(filePath string) (string, error) {
 	return strings.Join(docLines, "\n"), nil
 }
 
+// getHeader returns the lines of the file before its package clause, or all its lines if it has none.
+func getHeader(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	var lines []string
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", errors.Wrap(err, "failed to read file")
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
 func (p AutogeneratedExclude) Finish() {}
@@ -111,3 +111,39 @@ func TestGetDocFileWithLongLine(t *testing.T) {
 	_, err := getDoc(fpath)
 	assert.NoError(t, err)
 }
+
+func TestAutogeneratedExcludeGeneratedFilesLinters(t *testing.T) {
+	p := NewAutogeneratedExclude([]string{"goheader"}, nil)
+
+	generated := filepath.Join("testdata", "autogen_exclude_doc.go")
+
+	processAssertEmpty(t, p, newIssueFromIssueTestCase(issueTestCase{Path: generated, Line: 1, Linter: "govet"}))
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: generated, Line: 1, Linter: "goheader"}))
+}
+
+func TestHasGoFileExtension(t *testing.T) {
+	extensions := normalizeGoFileExtensions([]string{".go.tmpl", "gotpl", ""})
+
+	assert.True(t, hasGoFileExtension("a/b.go", extensions))
+	assert.True(t, hasGoFileExtension("a/b.go.tmpl", extensions))
+	assert.True(t, hasGoFileExtension("a/b.gotpl", extensions))
+	assert.False(t, hasGoFileExtension("a/b.tmpl", extensions))
+	assert.False(t, hasGoFileExtension("a/.go.tmpl", extensions))
+	assert.False(t, hasGoFileExtension("a/b.go.tmpl", nil))
+}
+
+func TestAutogeneratedExcludeGoFileExtensions(t *testing.T) {
+	generated := newIssueFromIssueTestCase(issueTestCase{
+		Path: filepath.Join("testdata", "autogen_exclude_generated.go.tmpl"), Line: 5, Linter: "govet",
+	})
+	handwritten := newIssueFromIssueTestCase(issueTestCase{
+		Path: filepath.Join("testdata", "autogen_exclude_handwritten.go.tmpl"), Line: 4, Linter: "govet",
+	})
+
+	// not Go source files by default.
+	processAssertEmpty(t, NewAutogeneratedExclude(nil, nil), generated, handwritten)
+
+	p := NewAutogeneratedExclude(nil, []string{".go.tmpl"})
+	processAssertEmpty(t, p, generated)
+	processAssertSame(t, p, handwritten)
+}
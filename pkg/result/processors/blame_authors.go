@@ -0,0 +1,168 @@
+package processors
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// uncommittedSHA is the commit of the lines not committed yet in git blame.
+const uncommittedSHA = "0000000000000000000000000000000000000000"
+
+// lineBlame is the last commit of a line, as reported by git blame.
+type lineBlame struct {
+	name       string
+	email      string
+	committed  bool // false for the lines not committed yet
+	commitTime time.Time
+}
+
+// blameFunc returns the blame of the lines of a file, indexed by line number.
+type blameFunc func(filePath string) (map[int]lineBlame, error)
+
+// BlameAuthors keeps only the issues on lines last touched by the included authors
+// and drops the issues on lines last touched by the excluded authors.
+// It's only a filter: it doesn't affect which linters are run.
+type BlameAuthors struct {
+	includeAuthors []string
+	excludeAuthors []string
+	log            logutils.Log
+
+	blame     blameFunc
+	fileCache map[string]map[int]lineBlame // nil value: blame is unavailable for the file
+}
+
+var _ Processor = &BlameAuthors{}
+
+func NewBlameAuthors(includeAuthors, excludeAuthors []string, log logutils.Log) *BlameAuthors {
+	return &BlameAuthors{
+		includeAuthors: includeAuthors,
+		excludeAuthors: excludeAuthors,
+		log:            log,
+		blame:          gitBlame,
+		fileCache:      map[string]map[int]lineBlame{},
+	}
+}
+
+func (p BlameAuthors) Name() string {
+	return "blame_authors"
+}
+
+func (p *BlameAuthors) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.includeAuthors) == 0 && len(p.excludeAuthors) == 0 { // no need to work
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		author, ok := p.getLineAuthor(i.FilePath(), i.Line())
+		if !ok {
+			// blame is unavailable: don't filter
+			return true
+		}
+
+		if len(p.includeAuthors) != 0 && !author.matchesAny(p.includeAuthors) {
+			return false
+		}
+
+		return !author.matchesAny(p.excludeAuthors)
+	}), nil
+}
+
+func (p BlameAuthors) Finish() {}
+
+func (p *BlameAuthors) getLineAuthor(filePath string, line int) (lineBlame, bool) {
+	authors, ok := p.fileCache[filePath]
+	if !ok {
+		// blame is computed once per file for all its issues.
+		var err error
+		authors, err = p.blame(filePath)
+		if err != nil {
+			p.log.Infof("Can't get git blame of %s, issues of this file aren't filtered by author: %s", filePath, err)
+			authors = nil
+		}
+		p.fileCache[filePath] = authors
+	}
+
+	author, ok := authors[line]
+	return author, ok
+}
+
+// matchesAny reports whether the author name or email is one of the given authors.
+func (a lineBlame) matchesAny(authors []string) bool {
+	for _, author := range authors {
+		if strings.EqualFold(author, a.name) || strings.EqualFold(author, a.email) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func gitBlame(filePath string) (map[int]lineBlame, error) {
+	out, err := exec.Command("git", "blame", "--line-porcelain", "--", filePath).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlamePorcelain(out)
+}
+
+// parseBlamePorcelain parses the output of `git blame --line-porcelain`:
+// each entry is a "<sha> <orig line> <final line> [<group size>]" header,
+// followed by "key value" lines, and ends by the tab-prefixed content of the line.
+func parseBlamePorcelain(out []byte) (map[int]lineBlame, error) {
+	blames := map[int]lineBlame{}
+
+	var (
+		line    int
+		current lineBlame
+	)
+
+	expectHeader := true
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	for scanner.Scan() {
+		text := scanner.Text()
+
+		switch {
+		case expectHeader:
+			fields := strings.Fields(text)
+			if len(fields) < 3 {
+				return nil, fmt.Errorf("invalid git blame entry header %q", text)
+			}
+
+			finalLine, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid git blame entry header %q: %w", text, err)
+			}
+
+			line = finalLine
+			current = lineBlame{committed: fields[0] != uncommittedSHA}
+			expectHeader = false
+		case strings.HasPrefix(text, "\t"):
+			blames[line] = current
+			expectHeader = true
+		case strings.HasPrefix(text, "author "):
+			current.name = strings.TrimPrefix(text, "author ")
+		case strings.HasPrefix(text, "author-mail "):
+			current.email = strings.Trim(strings.TrimPrefix(text, "author-mail "), "<>")
+		case strings.HasPrefix(text, "committer-time "):
+			sec, err := strconv.ParseInt(strings.TrimPrefix(text, "committer-time "), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid git blame committer time %q: %w", text, err)
+			}
+			current.commitTime = time.Unix(sec, 0)
+		}
+	}
+
+	return blames, scanner.Err()
+}
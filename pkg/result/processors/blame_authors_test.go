@@ -0,0 +1,103 @@
+package processors
+
+import (
+	"errors"
+	"go/token"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const blamePorcelain = `703f38cfb88948a78914508117905102dfd55119 1 1 2
+author John Doe
+author-mail <john@example.com>
+committer-time 1672531200
+summary first
+filename foo.go
+	package foo
+703f38cfb88948a78914508117905102dfd55119 2 2
+author John Doe
+author-mail <john@example.com>
+committer-time 1672531200
+summary first
+filename foo.go
+	
+0c4d2a8e8e3e0de1e44d2b57e4e0e7b4f42d5a11 1 3 1
+author renovate[bot]
+author-mail <bot@renovateapp.com>
+committer-time 1709251200
+summary second
+filename foo.go
+	var x = 1
+0000000000000000000000000000000000000000 4 4 1
+author Not Committed Yet
+author-mail <not.committed.yet>
+committer-time 1710000000
+summary Version of foo.go from foo.go
+filename foo.go
+	var y = 2
+`
+
+func TestParseBlamePorcelain(t *testing.T) {
+	blames, err := parseBlamePorcelain([]byte(blamePorcelain))
+	require.NoError(t, err)
+
+	expected := map[int]lineBlame{
+		1: {name: "John Doe", email: "john@example.com", committed: true, commitTime: time.Unix(1672531200, 0)},
+		2: {name: "John Doe", email: "john@example.com", committed: true, commitTime: time.Unix(1672531200, 0)},
+		3: {name: "renovate[bot]", email: "bot@renovateapp.com", committed: true, commitTime: time.Unix(1709251200, 0)},
+		4: {name: "Not Committed Yet", email: "not.committed.yet", commitTime: time.Unix(1710000000, 0)},
+	}
+	assert.Equal(t, expected, blames)
+}
+
+func newBlameAuthorsTestIssue(file string, line int) result.Issue {
+	return result.Issue{
+		Pos: token.Position{
+			Filename: file,
+			Line:     line,
+		},
+	}
+}
+
+func newTestBlameAuthors(includeAuthors, excludeAuthors []string) *BlameAuthors {
+	p := NewBlameAuthors(includeAuthors, excludeAuthors, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	p.blame = func(filePath string) (map[int]lineBlame, error) {
+		if filePath != "foo.go" {
+			return nil, errors.New("not a git file")
+		}
+		return parseBlamePorcelain([]byte(blamePorcelain))
+	}
+	return p
+}
+
+func TestBlameAuthorsInclude(t *testing.T) {
+	p := newTestBlameAuthors([]string{"john@example.com"}, nil)
+
+	processAssertSame(t, p, newBlameAuthorsTestIssue("foo.go", 1))
+	processAssertEmpty(t, p, newBlameAuthorsTestIssue("foo.go", 3))
+	processAssertSame(t, p, newBlameAuthorsTestIssue("bar.go", 3)) // blame is unavailable
+}
+
+func TestBlameAuthorsExclude(t *testing.T) {
+	p := newTestBlameAuthors(nil, []string{"renovate[bot]"})
+
+	processAssertSame(t, p, newBlameAuthorsTestIssue("foo.go", 1))
+	processAssertEmpty(t, p, newBlameAuthorsTestIssue("foo.go", 3))
+	processAssertSame(t, p, newBlameAuthorsTestIssue("foo.go", 42)) // unknown line
+}
+
+func TestBlameAuthorsDisabled(t *testing.T) {
+	p := newTestBlameAuthors(nil, nil)
+	p.blame = func(string) (map[int]lineBlame, error) {
+		t.Fatal("blame must not be computed")
+		return nil, nil
+	}
+
+	processAssertSame(t, p, newBlameAuthorsTestIssue("foo.go", 3))
+}
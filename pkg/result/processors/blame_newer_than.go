@@ -0,0 +1,93 @@
+package processors
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// BlameNewerThan keeps only the issues on lines committed since a date, according to git blame:
+// the old lines are grandfathered.
+// The issues on lines without blame (e.g. not committed, or outside a git repository) are kept.
+type BlameNewerThan struct {
+	since time.Time // zero: no filtering
+	log   logutils.Log
+
+	blame     blameFunc
+	fileCache map[string]map[int]lineBlame // nil value: blame is unavailable for the file
+}
+
+var _ Processor = &BlameNewerThan{}
+
+// NewBlameNewerThan returns a processor for a date like `2024-01-01` or `2024-01-01T15:04:05Z`.
+// An empty date disables the processor.
+func NewBlameNewerThan(date string, log logutils.Log) (*BlameNewerThan, error) {
+	p := &BlameNewerThan{
+		log:       log,
+		blame:     gitBlame,
+		fileCache: map[string]map[int]lineBlame{},
+	}
+
+	if date == "" {
+		return p, nil
+	}
+
+	since, err := parseBlameDate(date)
+	if err != nil {
+		return nil, err
+	}
+
+	p.since = since
+	return p, nil
+}
+
+func parseBlameDate(date string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q: expected a date like 2024-01-01 or 2024-01-01T15:04:05Z", date)
+}
+
+func (p BlameNewerThan) Name() string {
+	return "blame_newer_than"
+}
+
+func (p *BlameNewerThan) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.since.IsZero() { // no need to work
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		lb, ok := p.getLineBlame(i.FilePath(), i.Line())
+		if !ok || !lb.committed {
+			// new lines are always reported
+			return true
+		}
+
+		return !lb.commitTime.Before(p.since)
+	}), nil
+}
+
+func (p BlameNewerThan) Finish() {}
+
+func (p *BlameNewerThan) getLineBlame(filePath string, line int) (lineBlame, bool) {
+	blames, ok := p.fileCache[filePath]
+	if !ok {
+		// blame is computed once per file for all its issues.
+		var err error
+		blames, err = p.blame(filePath)
+		if err != nil {
+			p.log.Infof("Can't get git blame of %s, issues of this file aren't filtered by date: %s", filePath, err)
+			blames = nil
+		}
+		p.fileCache[filePath] = blames
+	}
+
+	lb, ok := blames[line]
+	return lb, ok
+}
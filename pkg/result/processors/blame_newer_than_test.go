@@ -0,0 +1,58 @@
+package processors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func newTestBlameNewerThan(t *testing.T, date string) *BlameNewerThan {
+	t.Helper()
+
+	p, err := NewBlameNewerThan(date, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	p.blame = func(filePath string) (map[int]lineBlame, error) {
+		if filePath != "foo.go" {
+			return nil, errors.New("not a git file")
+		}
+		return parseBlamePorcelain([]byte(blamePorcelain))
+	}
+
+	return p
+}
+
+func TestBlameNewerThan(t *testing.T) {
+	// the lines 1 and 2 are committed on 2023-01-01, the line 3 on 2024-03-01, the line 4 isn't committed.
+	p := newTestBlameNewerThan(t, "2024-01-01")
+
+	processAssertEmpty(t, p, newBlameAuthorsTestIssue("foo.go", 1), newBlameAuthorsTestIssue("foo.go", 2))
+	processAssertSame(t, p, newBlameAuthorsTestIssue("foo.go", 3))
+	processAssertSame(t, p, newBlameAuthorsTestIssue("foo.go", 4))  // not committed
+	processAssertSame(t, p, newBlameAuthorsTestIssue("foo.go", 42)) // unknown line
+	processAssertSame(t, p, newBlameAuthorsTestIssue("bar.go", 1))  // blame is unavailable
+}
+
+func TestBlameNewerThanSameDay(t *testing.T) {
+	p := newTestBlameNewerThan(t, "2023-01-01T00:00:00Z")
+
+	processAssertSame(t, p, newBlameAuthorsTestIssue("foo.go", 1))
+}
+
+func TestBlameNewerThanDisabled(t *testing.T) {
+	p := newTestBlameNewerThan(t, "")
+	p.blame = func(string) (map[int]lineBlame, error) {
+		t.Fatal("blame must not be computed")
+		return nil, nil
+	}
+
+	processAssertSame(t, p, newBlameAuthorsTestIssue("foo.go", 1))
+}
+
+func TestBlameNewerThanInvalidDate(t *testing.T) {
+	_, err := NewBlameNewerThan("01/01/2024", logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.Error(t, err)
+}
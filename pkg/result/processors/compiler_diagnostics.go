@@ -0,0 +1,132 @@
+package processors
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// compilerDiagnosticRe matches the diagnostics of `go build`, e.g. `./a.go:5:2: undefined: x`.
+var compilerDiagnosticRe = regexp.MustCompile(`^(.+?\.go):(\d+)(?::\d+)?: (.+)$`)
+
+var diagnosticQuotesReplacer = strings.NewReplacer("`", "", "'", "", `"`, "")
+
+// CompilerDiagnostics drops the typecheck issues already reported by the compiler,
+// for the pipelines running the compiler separately: an issue matches a diagnostic by file, line and message.
+type CompilerDiagnostics struct {
+	diagnostics map[string][]string // file:line -> normalized messages
+	log         logutils.Log
+
+	suppressedCount int
+}
+
+var _ Processor = &CompilerDiagnostics{}
+
+// NewCompilerDiagnostics returns a processor dropping the typecheck issues reported in the output of the compiler
+// saved in this file, `-` being the standard input. An empty path disables the processor.
+func NewCompilerDiagnostics(path string, log logutils.Log) (*CompilerDiagnostics, error) {
+	p := &CompilerDiagnostics{log: log}
+	if path == "" {
+		return p, nil
+	}
+
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("can't open the compiler diagnostics: %w", err)
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	diagnostics, err := parseCompilerDiagnostics(r)
+	if err != nil {
+		return nil, fmt.Errorf("can't read the compiler diagnostics: %w", err)
+	}
+
+	p.diagnostics = diagnostics
+	return p, nil
+}
+
+func parseCompilerDiagnostics(r io.Reader) (map[string][]string, error) {
+	diagnostics := map[string][]string{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		// the other lines (e.g. `# pkg` headers, indented details) are ignored.
+		m := compilerDiagnosticRe.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+
+		line, err := strconv.Atoi(m[2])
+		if err != nil {
+			continue
+		}
+
+		key := compilerDiagnosticKey(m[1], line)
+		diagnostics[key] = append(diagnostics[key], normalizeDiagnosticMessage(m[3]))
+	}
+
+	return diagnostics, scanner.Err()
+}
+
+func compilerDiagnosticKey(path string, line int) string {
+	path = filepath.Clean(path)
+	if absPath, err := filepath.Abs(path); err == nil {
+		path = absPath
+	}
+
+	return fmt.Sprintf("%s:%d", path, line)
+}
+
+// normalizeDiagnosticMessage makes the comparison tolerant of the formatting differences:
+// case, quotes, spaces and trailing punctuation.
+func normalizeDiagnosticMessage(message string) string {
+	message = diagnosticQuotesReplacer.Replace(strings.ToLower(message))
+	message = strings.Join(strings.Fields(message), " ")
+	return strings.TrimRight(message, ".;:")
+}
+
+func (p CompilerDiagnostics) Name() string {
+	return "compiler_diagnostics"
+}
+
+func (p *CompilerDiagnostics) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.diagnostics) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		if i.FromLinter != typecheckLinterName {
+			return true
+		}
+
+		text := normalizeDiagnosticMessage(i.Text)
+		for _, message := range p.diagnostics[compilerDiagnosticKey(i.FilePath(), i.Line())] {
+			// the messages of typecheck and of the compiler can differ by a prefix or a suffix.
+			if strings.Contains(text, message) || strings.Contains(message, text) {
+				p.suppressedCount++
+				return false
+			}
+		}
+
+		return true
+	}), nil
+}
+
+func (p CompilerDiagnostics) Finish() {
+	if p.suppressedCount > 0 {
+		p.log.Infof("Suppressed %d typecheck issues already reported by the compiler", p.suppressedCount)
+	}
+}
@@ -0,0 +1,71 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const compilerOutput = `# example.com/mp
+./a.go:5:2: undefined: x
+./a.go:8:10: cannot use "s" (untyped string constant) as int value in assignment
+	have (string)
+	want (int)
+sub/b.go:3: imported and not used: "fmt"
+`
+
+func newCompilerDiagnosticsTestIssue(linter, file string, line int, text string) result.Issue {
+	return result.Issue{
+		FromLinter: linter,
+		Text:       text,
+		Pos:        token.Position{Filename: file, Line: line},
+	}
+}
+
+func TestCompilerDiagnostics(t *testing.T) {
+	diagnostics, err := parseCompilerDiagnostics(strings.NewReader(compilerOutput))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 3)
+
+	p := &CompilerDiagnostics{diagnostics: diagnostics, log: logutils.NewStderrLog(logutils.DebugKeyEmpty)}
+
+	processAssertEmpty(t, p,
+		newCompilerDiagnosticsTestIssue("typecheck", "a.go", 5, "undefined: x"),
+		newCompilerDiagnosticsTestIssue("typecheck", "a.go", 8, `cannot use "s" (untyped string constant) as int value in assignment.`),
+		newCompilerDiagnosticsTestIssue("typecheck", filepath.Join("sub", "b.go"), 3, "\"fmt\" imported and not used: `fmt`"),
+	)
+
+	processAssertSame(t, p,
+		newCompilerDiagnosticsTestIssue("govet", "a.go", 5, "undefined: x"),      // not typecheck
+		newCompilerDiagnosticsTestIssue("typecheck", "a.go", 6, "undefined: x"),  // other line
+		newCompilerDiagnosticsTestIssue("typecheck", "b.go", 3, "undefined: x"),  // other file
+		newCompilerDiagnosticsTestIssue("typecheck", "a.go", 5, "undefined: yz"), // other message
+	)
+}
+
+func TestNewCompilerDiagnostics(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "build.log")
+	require.NoError(t, os.WriteFile(path, []byte(compilerOutput), 0o600))
+
+	p, err := NewCompilerDiagnostics(path, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	processAssertEmpty(t, p, newCompilerDiagnosticsTestIssue("typecheck", "a.go", 5, "undefined: x"))
+
+	_, err = NewCompilerDiagnostics(filepath.Join(t.TempDir(), "missing.log"), logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.Error(t, err)
+}
+
+func TestCompilerDiagnosticsDisabled(t *testing.T) {
+	p, err := NewCompilerDiagnostics("", logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newCompilerDiagnosticsTestIssue("typecheck", "a.go", 5, "undefined: x"))
+}
@@ -0,0 +1,90 @@
+package processors
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// ConfidenceRanking scores the issues by how likely they are real and worth a look,
+// and sorts them by descending score, ties kept in their order (e.g. sorted by position), optionally keeping the top ones.
+// The score is the product of the weight of the linter, of the proximity factor of the line
+// to the changes (see issues.diff-annotate) and of the severity factor.
+type ConfidenceRanking struct {
+	enabled bool
+	top     int
+	weights map[string]int
+}
+
+var _ Processor = ConfidenceRanking{}
+
+func NewConfidenceRanking(enabled bool, top int, weights map[string]int) *ConfidenceRanking {
+	return &ConfidenceRanking{
+		enabled: enabled,
+		top:     top,
+		weights: weights,
+	}
+}
+
+func (p ConfidenceRanking) Name() string {
+	return "confidence_ranking"
+}
+
+func (p ConfidenceRanking) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	ranked := transformIssues(issues, func(i *result.Issue) *result.Issue {
+		newI := *i
+		newI.Confidence = p.score(i)
+		return &newI
+	})
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Confidence > ranked[j].Confidence
+	})
+
+	if p.top > 0 && len(ranked) > p.top {
+		ranked = ranked[:p.top]
+	}
+
+	return ranked, nil
+}
+
+func (p ConfidenceRanking) Finish() {}
+
+func (p ConfidenceRanking) score(i *result.Issue) int {
+	weight, ok := p.weights[i.FromLinter]
+	if !ok {
+		weight = defaultLinterWeight
+	}
+
+	return weight * diffProximityFactor(i.DiffLineType) * severityFactor(i.Severity)
+}
+
+// diffProximityFactor ranks the changed lines first, then the lines around the changes.
+// The issues outside a diff have the lowest factor.
+func diffProximityFactor(lineType string) int {
+	switch lineType {
+	case result.DiffLineTypeAdded:
+		return 3
+	case result.DiffLineTypeContext:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// severityFactor ranks the errors first, then the warnings, then the other severities.
+func severityFactor(severity string) int {
+	switch strings.ToLower(severity) {
+	case "error":
+		return 3
+	case "warning":
+		return 2
+	default:
+		return 1
+	}
+}
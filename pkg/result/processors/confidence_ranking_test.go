@@ -0,0 +1,52 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestConfidenceRanking(t *testing.T) {
+	p := NewConfidenceRanking(true, 0, map[string]int{"gosec": 5})
+
+	lowest := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "lll"})
+	warning := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Linter: "lll"})
+	warning.Severity = "warning"
+	changed := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 3, Linter: "lll"})
+	changed.DiffLineType = result.DiffLineTypeAdded
+	weighted := newIssueFromIssueTestCase(issueTestCase{Path: "b.go", Line: 1, Linter: "gosec"})
+	sameScore := newIssueFromIssueTestCase(issueTestCase{Path: "b.go", Line: 2, Linter: "lll"})
+
+	processed := process(t, p, lowest, warning, changed, weighted, sameScore)
+
+	weighted.Confidence = 5
+	changed.Confidence = 3
+	warning.Confidence = 2
+	lowest.Confidence = 1
+	sameScore.Confidence = 1
+
+	assert.Equal(t, []result.Issue{weighted, changed, warning, lowest, sameScore}, processed)
+}
+
+func TestConfidenceRankingTop(t *testing.T) {
+	p := NewConfidenceRanking(true, 1, nil)
+
+	low := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "lll"})
+	high := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Linter: "govet"})
+	high.Severity = "error"
+
+	processed := process(t, p, low, high)
+
+	high.Confidence = 3
+	assert.Equal(t, []result.Issue{high}, processed)
+}
+
+func TestConfidenceRankingDisabled(t *testing.T) {
+	p := NewConfidenceRanking(false, 1, nil)
+
+	processAssertSame(t, p,
+		newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "lll"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Linter: "govet"}))
+}
@@ -0,0 +1,120 @@
+package processors
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/cover"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// CoverageSeverity raises by one level (see severityLevels) the severity of the issues
+// on the lines not covered by the tests, according to a Go coverage profile (`go test -coverprofile`).
+// The issues of the files absent from the profile, on the lines outside its blocks,
+// or with another severity keep their severity.
+type CoverageSeverity struct {
+	// files are the coverage of the lines of each file of the profile, by import path of the file.
+	files map[string]map[int]bool
+}
+
+var _ Processor = &CoverageSeverity{}
+
+func NewCoverageSeverity(profilePath string) (*CoverageSeverity, error) {
+	p := &CoverageSeverity{}
+	if profilePath == "" {
+		return p, nil
+	}
+
+	profiles, err := cover.ParseProfiles(profilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the coverage profile %s: %w", profilePath, err)
+	}
+
+	p.files = make(map[string]map[int]bool, len(profiles))
+	for _, profile := range profiles {
+		p.files[profile.FileName] = coveredLines(profile)
+	}
+
+	return p, nil
+}
+
+// coveredLines returns whether each line of the blocks of the profile is covered:
+// a line of several blocks is covered if any of them is.
+func coveredLines(profile *cover.Profile) map[int]bool {
+	lines := map[int]bool{}
+	for _, block := range profile.Blocks {
+		for line := block.StartLine; line <= block.EndLine; line++ {
+			lines[line] = lines[line] || block.Count > 0
+		}
+	}
+
+	return lines
+}
+
+func (p CoverageSeverity) Name() string {
+	return "coverage_severity"
+}
+
+func (p *CoverageSeverity) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.files == nil { // disabled
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		lines := p.findFile(i)
+		if lines == nil {
+			return i
+		}
+
+		covered, ok := lines[i.Line()]
+		if !ok || covered {
+			return i
+		}
+
+		severity := raiseSeverity(i.Severity)
+		if severity == i.Severity {
+			return i
+		}
+
+		newI := *i
+		newI.Severity = severity
+		newI.SeverityReason = strings.TrimPrefix(i.SeverityReason+", raised: line not covered", ", ")
+		return &newI
+	}), nil
+}
+
+func (p CoverageSeverity) Finish() {}
+
+// findFile returns the coverage of the lines of the file of the issue:
+// the profile has the import paths of the files, e.g. `github.com/foo/bar/file.go`.
+func (p *CoverageSeverity) findFile(i *result.Issue) map[int]bool {
+	if i.Pkg != nil && i.Pkg.PkgPath != "" {
+		if lines, ok := p.files[path.Join(i.Pkg.PkgPath, filepath.Base(i.FilePath()))]; ok {
+			return lines
+		}
+	}
+
+	// without the package, the import path must end with the relative path of the file.
+	suffix := "/" + filepath.ToSlash(i.FilePath())
+	for fileName, lines := range p.files {
+		if strings.HasSuffix(fileName, suffix) {
+			return lines
+		}
+	}
+
+	return nil
+}
+
+// raiseSeverity returns the next severity level, the same severity for the highest or an unknown one.
+func raiseSeverity(severity string) string {
+	for ind, level := range severityLevels {
+		if level == severity && ind+1 < len(severityLevels) {
+			return severityLevels[ind+1]
+		}
+	}
+
+	return severity
+}
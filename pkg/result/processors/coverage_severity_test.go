@@ -0,0 +1,63 @@
+package processors
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestCoverageSeverity(t *testing.T) {
+	p, err := NewCoverageSeverity(filepath.Join("testdata", "coverage_severity", "cover.out"))
+	require.NoError(t, err)
+
+	pkg := &packages.Package{PkgPath: "example.com/mod/pkg"}
+
+	newIssue := func(path string, line int, severity string) result.Issue {
+		i := newIssueFromIssueTestCase(issueTestCase{Path: path, Linter: "govet", Line: line})
+		i.Severity = severity
+		i.Pkg = pkg
+		return i
+	}
+
+	covered := newIssue("pkg/file.go", 4, "warning")
+	uncoveredInfo := newIssue("pkg/file.go", 8, "info")
+	uncoveredWarning := newIssue("pkg/file.go", 8, "warning")
+	uncoveredError := newIssue("pkg/file.go", 8, "error")
+	unknownSeverity := newIssue("pkg/file.go", 8, "minor")
+	// line 12 is in both an uncovered and a covered block.
+	partiallyCovered := newIssue("pkg/file.go", 12, "warning")
+	outsideBlocks := newIssue("pkg/file.go", 1, "warning")
+	absentFile := newIssue("pkg/absent.go", 8, "warning")
+
+	// without the package, the file is found by the end of its import path.
+	withoutPkg := newIssue("other/other.go", 4, "info")
+	withoutPkg.Pkg = nil
+
+	processed := process(t, p, covered, uncoveredInfo, uncoveredWarning, uncoveredError, unknownSeverity,
+		partiallyCovered, outsideBlocks, absentFile, withoutPkg)
+
+	severities := make([]string, 0, len(processed))
+	for _, i := range processed {
+		severities = append(severities, i.Severity)
+	}
+
+	expected := []string{"warning", "warning", "error", "error", "minor", "warning", "warning", "warning", "warning"}
+	assert.Equal(t, expected, severities)
+}
+
+func TestCoverageSeverityDisabled(t *testing.T) {
+	p, err := NewCoverageSeverity("")
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Linter: "govet", Line: 1}))
+}
+
+func TestCoverageSeverityInvalidProfile(t *testing.T) {
+	_, err := NewCoverageSeverity(filepath.Join("testdata", "coverage_severity", "missing.out"))
+	assert.Error(t, err)
+}
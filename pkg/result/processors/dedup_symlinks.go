@@ -0,0 +1,135 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// DedupSymlinks drops the duplicated issues reported for the same file reached by different paths,
+// e.g. by its real path and through a symlinked directory.
+// The issues reported at the real path of the file are kept if any, the ones at the first path otherwise:
+// the dropped issues are kept on the first of them (see Issue.Duplicates), it takes the highest severity.
+// The duplicates reported at the same path aren't symlink duplicates: they are kept.
+type DedupSymlinks struct {
+	enabled bool
+
+	absPaths     map[string]string // path -> absolute path
+	resolvedDirs map[string]string // directory -> directory with the symlinks resolved
+	realPaths    map[string]string // absolute path -> real path of the file
+}
+
+var _ Processor = &DedupSymlinks{}
+
+func NewDedupSymlinks(enabled bool) *DedupSymlinks {
+	return &DedupSymlinks{
+		enabled:      enabled,
+		absPaths:     map[string]string{},
+		resolvedDirs: map[string]string{},
+		realPaths:    map[string]string{},
+	}
+}
+
+func (p DedupSymlinks) Name() string {
+	return "dedup_symlinks"
+}
+
+func (p *DedupSymlinks) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	// the paths are compared absolute: the issues can be reported at relative and absolute paths,
+	// and the symlinks can point to absolute paths.
+	paths := make([]string, len(issues))
+	keys := make([]issueVariantKey, len(issues))
+	keptPaths := map[issueVariantKey]string{}
+	for ind := range issues {
+		path := p.absPath(issues[ind].FilePath())
+		realPath := p.realPath(path)
+		paths[ind] = path
+
+		keys[ind] = issueVariantKey{
+			file:       realPath,
+			line:       issues[ind].Line(),
+			col:        issues[ind].Column(),
+			fromLinter: issues[ind].FromLinter,
+			text:       issues[ind].Text,
+		}
+
+		// prefer the real path: the one the user edits.
+		if _, ok := keptPaths[keys[ind]]; !ok || path == realPath {
+			keptPaths[keys[ind]] = path
+		}
+	}
+
+	retIssues := make([]result.Issue, 0, len(issues))
+	survivors := map[issueVariantKey]int{} // index in retIssues
+	var dropped []int
+	for ind := range issues {
+		if paths[ind] != keptPaths[keys[ind]] {
+			dropped = append(dropped, ind)
+			continue
+		}
+
+		if _, ok := survivors[keys[ind]]; !ok {
+			survivors[keys[ind]] = len(retIssues)
+		}
+		retIssues = append(retIssues, issues[ind])
+	}
+
+	for _, ind := range dropped {
+		addDuplicate(&retIssues[survivors[keys[ind]]], &issues[ind])
+	}
+
+	return retIssues, nil
+}
+
+func (p DedupSymlinks) Finish() {}
+
+// absPath returns the absolute path of the file, cached by path.
+func (p *DedupSymlinks) absPath(path string) string {
+	if absPath, ok := p.absPaths[path]; ok {
+		return absPath
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		absPath = filepath.Clean(path)
+	}
+
+	p.absPaths[path] = absPath
+	return absPath
+}
+
+// realPath returns the absolute path of the file with the symlinks resolved, cached by absolute path:
+// the resolution of the directories is cached too, the files of a directory share it.
+func (p *DedupSymlinks) realPath(path string) string {
+	if realPath, ok := p.realPaths[path]; ok {
+		return realPath
+	}
+
+	dir, base := filepath.Split(path)
+
+	resolvedDir, ok := p.resolvedDirs[dir]
+	if !ok {
+		resolvedDir = dir
+		if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+			resolvedDir = resolved
+		}
+		p.resolvedDirs[dir] = resolvedDir
+	}
+
+	realPath := filepath.Join(resolvedDir, base)
+
+	// the file itself can be a symlink.
+	if fi, err := os.Lstat(realPath); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+		if resolved, err := filepath.EvalSymlinks(realPath); err == nil {
+			realPath = resolved
+		}
+	}
+
+	p.realPaths[path] = realPath
+	return realPath
+}
@@ -0,0 +1,77 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestDedupSymlinks(t *testing.T) {
+	root := t.TempDir()
+
+	realDir := filepath.Join(root, "real")
+	require.NoError(t, os.MkdirAll(realDir, 0o755))
+	realFile := filepath.Join(realDir, "a.go")
+	require.NoError(t, os.WriteFile(realFile, []byte("package a\n"), 0o600))
+
+	linkDir := filepath.Join(root, "link")
+	if err := os.Symlink(realDir, linkDir); err != nil {
+		t.Skipf("can't create symlink: %s", err)
+	}
+	linkFile := filepath.Join(linkDir, "a.go")
+
+	// the root can be a symlink too (e.g. on macOS).
+	resolvedRealFile, err := filepath.EvalSymlinks(realFile)
+	require.NoError(t, err)
+
+	viaLink := newIssueFromIssueTestCase(issueTestCase{Path: linkFile, Line: 1, Linter: "govet", Text: "issue"})
+	atReal := newIssueFromIssueTestCase(issueTestCase{Path: resolvedRealFile, Line: 1, Linter: "govet", Text: "issue"})
+	otherLine := newIssueFromIssueTestCase(issueTestCase{Path: linkFile, Line: 2, Linter: "govet", Text: "issue"})
+
+	p := NewDedupSymlinks(true)
+
+	kept := atReal
+	kept.Duplicates = []result.Issue{viaLink}
+	assert.Equal(t, []result.Issue{otherLine, kept}, process(t, p, viaLink, otherLine, atReal))
+
+	// the duplicates at the same path aren't reached through a symlink: they are kept.
+	assert.Equal(t, []result.Issue{kept, atReal}, process(t, p, atReal, viaLink, atReal))
+	assert.Equal(t, []result.Issue{viaLink, viaLink}, process(t, p, viaLink, viaLink))
+}
+
+func TestDedupSymlinksAbsoluteTarget(t *testing.T) {
+	// the root can be a symlink (e.g. on macOS): the issues at the real path are kept.
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "real.go"), []byte("package a\n"), 0o600))
+
+	// the target of the symlink is absolute, the issues are reported at relative paths.
+	if err := os.Symlink(filepath.Join(root, "real.go"), filepath.Join(root, "link.go")); err != nil {
+		t.Skipf("can't create symlink: %s", err)
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(root))
+	t.Cleanup(func() { require.NoError(t, os.Chdir(wd)) })
+
+	viaLink := newIssueFromIssueTestCase(issueTestCase{Path: "link.go", Line: 1, Linter: "govet", Text: "issue"})
+	atReal := newIssueFromIssueTestCase(issueTestCase{Path: "real.go", Line: 1, Linter: "govet", Text: "issue"})
+
+	kept := atReal
+	kept.Duplicates = []result.Issue{viaLink}
+	assert.Equal(t, []result.Issue{kept}, process(t, NewDedupSymlinks(true), viaLink, atReal))
+}
+
+func TestDedupSymlinksDisabled(t *testing.T) {
+	p := NewDedupSymlinks(false)
+
+	issue := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "govet", Text: "issue"})
+	processAssertSame(t, p, issue, issue)
+}
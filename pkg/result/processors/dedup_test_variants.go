@@ -0,0 +1,69 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type issueVariantKey struct {
+	file       string
+	line, col  int
+	fromLinter string
+	text       string
+}
+
+// DedupTestVariants drops duplicated issues reported for the same file
+// by both the normal and the test variant of a package.
+// The dropped issues are kept on the kept one (see Issue.Duplicates): it takes the highest severity.
+type DedupTestVariants struct {
+	enabled bool
+	seen    map[issueVariantKey]bool
+}
+
+var _ Processor = &DedupTestVariants{}
+
+func NewDedupTestVariants(enabled bool) *DedupTestVariants {
+	return &DedupTestVariants{
+		enabled: enabled,
+		seen:    map[issueVariantKey]bool{},
+	}
+}
+
+func (p DedupTestVariants) Name() string {
+	return "dedup_test_variants"
+}
+
+func (p *DedupTestVariants) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	retIssues := make([]result.Issue, 0, len(issues))
+	kept := map[issueVariantKey]int{} // index in retIssues of the issues kept in this call
+	for ind := range issues {
+		i := &issues[ind]
+
+		// the package isn't a part of the key: only the package variant differs.
+		key := issueVariantKey{
+			file:       i.FilePath(),
+			line:       i.Line(),
+			col:        i.Column(),
+			fromLinter: i.FromLinter,
+			text:       i.Text,
+		}
+
+		if p.seen[key] {
+			if keptInd, ok := kept[key]; ok {
+				addDuplicate(&retIssues[keptInd], i)
+			}
+			continue
+		}
+
+		p.seen[key] = true
+		kept[key] = len(retIssues)
+		retIssues = append(retIssues, *i)
+	}
+
+	return retIssues, nil
+}
+
+func (p DedupTestVariants) Finish() {}
@@ -0,0 +1,60 @@
+package processors
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newVariantIssue(pkgID string, line, col int, text string) result.Issue {
+	return result.Issue{
+		FromLinter: "staticcheck",
+		Text:       text,
+		Pkg:        &packages.Package{ID: pkgID},
+		Pos: token.Position{
+			Filename: "foo/foo.go",
+			Line:     line,
+			Column:   col,
+		},
+	}
+}
+
+func TestDedupTestVariants(t *testing.T) {
+	p := NewDedupTestVariants(true)
+
+	processAssertSame(t, p, newVariantIssue("foo", 10, 2, "issue"))
+	processAssertEmpty(t, p, newVariantIssue("foo [foo.test]", 10, 2, "issue")) // test variant
+
+	processAssertSame(t, p, newVariantIssue("foo [foo.test]", 10, 3, "issue"))         // another column
+	processAssertSame(t, p, newVariantIssue("foo [foo.test]", 10, 2, "another issue")) // another text
+}
+
+func TestDedupTestVariantsSeverity(t *testing.T) {
+	normal := newVariantIssue("foo", 10, 2, "issue")
+	normal.Severity = "warning"
+	testVariant := newVariantIssue("foo [foo.test]", 10, 2, "issue")
+	testVariant.Severity = "error"
+
+	issues := process(t, NewDedupTestVariants(true), normal, testVariant)
+	require.Len(t, issues, 1)
+	assert.Equal(t, []result.Issue{testVariant}, issues[0].Duplicates)
+
+	// the severity rules give the kept issue the highest severity.
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	issues = process(t, NewSeverityRules("", nil, nil, lineCache, logutils.NewStderrLog(logutils.DebugKeyEmpty)), issues...)
+	assert.Equal(t, "error", issues[0].Severity)
+}
+
+func TestDedupTestVariantsDisabled(t *testing.T) {
+	p := NewDedupTestVariants(false)
+
+	processAssertSame(t, p, newVariantIssue("foo", 10, 2, "issue"))
+	processAssertSame(t, p, newVariantIssue("foo [foo.test]", 10, 2, "issue"))
+}
@@ -2,14 +2,18 @@ package processors
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/golangci/revgrep"
 
 	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/sliceutil"
 )
 
 const envGolangciDiffProcessorPatch = "GOLANGCI_DIFF_PROCESSOR_PATCH"
@@ -20,26 +24,42 @@ type Diff struct {
 	patchFilePath string
 	wholeFiles    bool
 	patch         string
+
+	// annotate keeps the issues on the context lines of the hunks too, and sets Issue.DiffLineType.
+	annotate bool
+
+	// workingTree diffs the working tree against the checked out revision, stagedOnly keeps only the staged changes.
+	workingTree bool
+	stagedOnly  bool
 }
 
 var _ Processor = Diff{}
 
-func NewDiff(onlyNew bool, fromRev, patchFilePath string, wholeFiles bool) *Diff {
+func NewDiff(onlyNew bool, fromRev, patchFilePath string, wholeFiles, annotate bool) *Diff {
 	return &Diff{
 		onlyNew:       onlyNew,
 		fromRev:       fromRev,
 		patchFilePath: patchFilePath,
 		wholeFiles:    wholeFiles,
 		patch:         os.Getenv(envGolangciDiffProcessorPatch),
+		annotate:      annotate,
 	}
 }
 
+// WithWorkingTree makes the processor keep the issues of the uncommitted changes:
+// the staged and unstaged changes and the untracked files, or only the staged changes if stagedOnly is set.
+func (p *Diff) WithWorkingTree(enabled, stagedOnly bool) *Diff {
+	p.workingTree = enabled || stagedOnly
+	p.stagedOnly = stagedOnly
+	return p
+}
+
 func (p Diff) Name() string {
 	return "diff"
 }
 
 func (p Diff) Process(issues []result.Issue) ([]result.Issue, error) {
-	if !p.onlyNew && p.fromRev == "" && p.patchFilePath == "" && p.patch == "" { // no need to work
+	if !p.onlyNew && p.fromRev == "" && p.patchFilePath == "" && p.patch == "" && !p.workingTree { // no need to work
 		return issues, nil
 	}
 
@@ -54,8 +74,40 @@ func (p Diff) Process(issues []result.Issue) ([]result.Issue, error) {
 		patchReader = strings.NewReader(p.patch)
 	}
 
+	var newFiles []string
+	if patchReader == nil {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, fmt.Errorf("can't get working directory: %s", err)
+		}
+
+		if p.workingTree {
+			patchReader, newFiles, err = detectVCS(wd).WorkingTreePatch(p.stagedOnly)
+		} else {
+			patchReader, newFiles, err = detectVCS(wd).Patch(p.fromRev)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("can't prepare diff by revgrep: %s", err)
+		}
+		if patchReader == nil {
+			return nil, errors.New("can't prepare diff by revgrep: no version control repository found")
+		}
+	}
+
+	var lineTypes map[string]map[int]string
+	if p.annotate {
+		// the patch is read twice: by revgrep and to find the context lines.
+		patch, err := io.ReadAll(patchReader)
+		if err != nil {
+			return nil, fmt.Errorf("can't read patch: %s", err)
+		}
+		patchReader = bytes.NewReader(patch)
+		lineTypes = parsePatchLineTypes(patch)
+	}
+
 	c := revgrep.Checker{
 		Patch:        patchReader,
+		NewFiles:     newFiles,
 		RevisionFrom: p.fromRev,
 		WholeFiles:   p.wholeFiles,
 	}
@@ -65,14 +117,73 @@ func (p Diff) Process(issues []result.Issue) ([]result.Issue, error) {
 
 	return transformIssues(issues, func(i *result.Issue) *result.Issue {
 		hunkPos, isNew := c.IsNewIssue(i)
-		if !isNew {
+		if !isNew && !p.annotate {
+			return nil
+		}
+
+		lineType := lineTypes[filepath.ToSlash(i.FilePath())][i.Line()]
+		switch {
+		case isNew && lineType == "":
+			// a line outside the hunks: a new file, or any line of a changed file with whole-files.
+			lineType = result.DiffLineTypeAdded
+			if p.wholeFiles && !sliceutil.Contains(newFiles, filepath.ToSlash(i.FilePath())) {
+				lineType = result.DiffLineTypeUnchanged
+			}
+		case !isNew && lineType != result.DiffLineTypeContext:
 			return nil
 		}
 
 		newI := *i
 		newI.HunkPos = hunkPos
+		if p.annotate {
+			newI.DiffLineType = lineType
+		}
 		return &newI
 	}), nil
 }
 
 func (Diff) Finish() {}
+
+// parsePatchLineTypes returns the types of the lines of the changed files in the unified diff,
+// by file path and line number in the new version of the file.
+// The file paths are parsed like revgrep does.
+func parsePatchLineTypes(patch []byte) map[string]map[int]string {
+	lineTypes := map[string]map[int]string{}
+
+	var (
+		file   map[int]string
+		lineNo int
+	)
+
+	for _, line := range strings.Split(string(patch), "\n") {
+		line = strings.TrimRight(line, "\r")
+
+		switch {
+		case strings.HasPrefix(line, "+++ ") && len(line) > 6:
+			// 6 removes "+++ b/"
+			file = map[int]string{}
+			lineTypes[line[6:]] = file
+		case file == nil:
+			// the header of the patch.
+		case strings.HasPrefix(line, "@@ "):
+			// @@ -1,3 +2,4 @@
+			parts := strings.Split(line, " ")
+			if len(parts) < 3 {
+				continue
+			}
+			start, err := strconv.Atoi(strings.Split(strings.TrimPrefix(parts[2], "+"), ",")[0])
+			if err != nil {
+				continue
+			}
+			lineNo = start
+		case strings.HasPrefix(line, "+"):
+			file[lineNo] = result.DiffLineTypeAdded
+			lineNo++
+		case strings.HasPrefix(line, " "):
+			file[lineNo] = result.DiffLineTypeContext
+			lineNo++
+		}
+	}
+
+	return lineTypes
+}
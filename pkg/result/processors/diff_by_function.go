@@ -0,0 +1,198 @@
+package processors
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/sliceutil"
+)
+
+// fileChanges are the changes of a file since the base revision.
+type fileChanges struct {
+	isNew        bool // the file doesn't exist at the base revision
+	isChanged    bool
+	changedFuncs []funcRange // current positions of the functions changed since the base revision
+}
+
+// DiffByFunction keeps only the issues of the functions changed since a base revision:
+// a function is changed if its declaration (signature and body) isn't in the base revision of the file,
+// functions moved without changes inside the file aren't changed.
+type DiffByFunction struct {
+	enabled  bool
+	revision string
+	log      logutils.Log
+
+	fileAtRevision func(revision, filePath string) ([]byte, bool, error)
+	fileCache      map[string]*fileChanges
+}
+
+var _ Processor = (*DiffByFunction)(nil)
+
+func NewDiffByFunction(enabled bool, revision string, log logutils.Log) (*DiffByFunction, error) {
+	if enabled && revision == "" {
+		return nil, errors.New("the diff by function requires a base revision (new-from-rev)")
+	}
+
+	p := &DiffByFunction{
+		enabled:   enabled,
+		revision:  revision,
+		log:       log,
+		fileCache: map[string]*fileChanges{},
+	}
+
+	if enabled {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, fmt.Errorf("can't get working directory: %w", err)
+		}
+		p.fileAtRevision = detectVCS(wd).FileAtRevision
+	}
+
+	return p, nil
+}
+
+func (p DiffByFunction) Name() string {
+	return "diff_by_function"
+}
+
+func (p *DiffByFunction) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	return filterIssuesErr(issues, func(i *result.Issue) (bool, error) {
+		changes, err := p.getFileChanges(i.FilePath())
+		if err != nil {
+			return false, err
+		}
+
+		if changes.isNew {
+			return true, nil
+		}
+
+		if filepath.Ext(i.FilePath()) != ".go" {
+			// no functions: keep the issues of the changed files
+			return changes.isChanged, nil
+		}
+
+		for _, r := range changes.changedFuncs {
+			if isPositionInRange(i.Line(), i.Column(), r.from, r.to) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+}
+
+func (p DiffByFunction) Finish() {}
+
+func (p *DiffByFunction) getFileChanges(filePath string) (*fileChanges, error) {
+	if changes, ok := p.fileCache[filePath]; ok {
+		return changes, nil
+	}
+
+	changes, err := p.computeFileChanges(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	p.fileCache[filePath] = changes
+	return changes, nil
+}
+
+func (p *DiffByFunction) computeFileChanges(filePath string) (*fileChanges, error) {
+	baseContent, exists, err := p.fileAtRevision(p.revision, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't get %s at revision %s: %w", filePath, p.revision, err)
+	}
+
+	if !exists {
+		return &fileChanges{isNew: true}, nil
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't read %s: %w", filePath, err)
+	}
+
+	if bytes.Equal(content, baseContent) {
+		return &fileChanges{}, nil
+	}
+
+	if filepath.Ext(filePath) != ".go" {
+		return &fileChanges{isChanged: true}, nil
+	}
+
+	changedFuncs, err := getChangedFuncs(filePath, baseContent, content)
+	if err != nil {
+		// e.g. a file not compiling, at the revision or now: keep its issues, typecheck reports the errors.
+		p.log.Infof("Can't compare the functions of %s with revision %s, all its issues are kept: %s", filePath, p.revision, err)
+		return &fileChanges{isNew: true}, nil
+	}
+
+	return &fileChanges{isChanged: true, changedFuncs: changedFuncs}, nil
+}
+
+// getChangedFuncs returns the current positions of the functions whose declarations aren't in the base content.
+func getChangedFuncs(filePath string, baseContent, content []byte) ([]funcRange, error) {
+	baseFset := token.NewFileSet()
+	baseFile, err := parser.ParseFile(baseFset, filePath, baseContent, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// a name can be declared several times, e.g. init functions.
+	baseFuncs := map[string][]string{}
+	for _, decl := range baseFile.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok {
+			name := funcDeclName(fd)
+			baseFuncs[name] = append(baseFuncs[name], printFuncDecl(baseFset, fd))
+		}
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filePath, content, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var changedFuncs []funcRange
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		name := funcDeclName(fd)
+		if sliceutil.Contains(baseFuncs[name], printFuncDecl(fset, fd)) {
+			continue
+		}
+
+		changedFuncs = append(changedFuncs, funcRange{
+			from: fset.Position(fd.Pos()),
+			to:   fset.Position(fd.End()),
+			name: name,
+		})
+	}
+
+	return changedFuncs, nil
+}
+
+// printFuncDecl returns the formatted declaration: formatting and comments changes are ignored.
+func printFuncDecl(fset *token.FileSet, fd *ast.FuncDecl) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, fd); err != nil {
+		return ""
+	}
+	return buf.String()
+}
@@ -0,0 +1,123 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const diffByFunctionBase = `package p
+
+func unchanged() int {
+	return 1
+}
+
+func changed() int {
+	return 2
+}
+
+func moved() int {
+	return 3
+}
+`
+
+const diffByFunctionCurrent = `package p
+
+func moved() int {
+	return 3
+}
+
+// unchanged has a new comment.
+func unchanged() int {
+	return 1
+}
+
+func changed() int {
+	return 20
+}
+
+var v = 1
+`
+
+func newTestDiffByFunction(t *testing.T, baseFiles map[string]string) *DiffByFunction {
+	t.Helper()
+
+	p, err := NewDiffByFunction(true, "HEAD", logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	p.fileAtRevision = func(revision, filePath string) ([]byte, bool, error) {
+		assert.Equal(t, "HEAD", revision)
+
+		content, ok := baseFiles[filePath]
+		return []byte(content), ok, nil
+	}
+
+	return p
+}
+
+func newDiffByFunctionIssue(filePath string, line int) result.Issue {
+	return result.Issue{
+		FromLinter: "linter",
+		Text:       "text",
+		Pos: token.Position{
+			Filename: filePath,
+			Line:     line,
+		},
+	}
+}
+
+func TestDiffByFunction(t *testing.T) {
+	dir := t.TempDir()
+
+	changedFile := filepath.Join(dir, "changed.go")
+	require.NoError(t, os.WriteFile(changedFile, []byte(diffByFunctionCurrent), 0o600))
+
+	newFile := filepath.Join(dir, "new.go")
+	require.NoError(t, os.WriteFile(newFile, []byte(diffByFunctionCurrent), 0o600))
+
+	p := newTestDiffByFunction(t, map[string]string{changedFile: diffByFunctionBase})
+
+	movedIssue := newDiffByFunctionIssue(changedFile, 4)
+	unchangedIssue := newDiffByFunctionIssue(changedFile, 9)
+	changedIssue := newDiffByFunctionIssue(changedFile, 13)
+	outsideFuncIssue := newDiffByFunctionIssue(changedFile, 16)
+	newFileIssue := newDiffByFunctionIssue(newFile, 16)
+
+	processed, err := p.Process([]result.Issue{movedIssue, unchangedIssue, changedIssue, outsideFuncIssue, newFileIssue})
+	require.NoError(t, err)
+	assert.Equal(t, []result.Issue{changedIssue, newFileIssue}, processed)
+}
+
+func TestDiffByFunctionNonGoFile(t *testing.T) {
+	dir := t.TempDir()
+
+	changedFile := filepath.Join(dir, "changed.txt")
+	require.NoError(t, os.WriteFile(changedFile, []byte("b"), 0o600))
+
+	unchangedFile := filepath.Join(dir, "unchanged.txt")
+	require.NoError(t, os.WriteFile(unchangedFile, []byte("a"), 0o600))
+
+	p := newTestDiffByFunction(t, map[string]string{changedFile: "a", unchangedFile: "a"})
+
+	changedIssue := newDiffByFunctionIssue(changedFile, 1)
+
+	processed, err := p.Process([]result.Issue{changedIssue, newDiffByFunctionIssue(unchangedFile, 1)})
+	require.NoError(t, err)
+	assert.Equal(t, []result.Issue{changedIssue}, processed)
+}
+
+func TestDiffByFunctionRequiresRevision(t *testing.T) {
+	_, err := NewDiffByFunction(true, "", logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.Error(t, err)
+
+	p, err := NewDiffByFunction(false, "", logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+	processAssertSame(t, p, newDiffByFunctionIssue("a.go", 1))
+}
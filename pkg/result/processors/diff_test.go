@@ -0,0 +1,129 @@
+package processors
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const diffTestPatch = `diff --git a/foo.go b/foo.go
+index 0000001..0000002 100644
+--- a/foo.go
++++ b/foo.go
+@@ -1,5 +1,6 @@
+ package foo
+ 
+-func foo() {}
++func foo() {
++}
+ 
+ func bar() {}
+`
+
+func TestParsePatchLineTypes(t *testing.T) {
+	expected := map[string]map[int]string{
+		"foo.go": {
+			1: result.DiffLineTypeContext,
+			2: result.DiffLineTypeContext,
+			3: result.DiffLineTypeAdded,
+			4: result.DiffLineTypeAdded,
+			5: result.DiffLineTypeContext,
+			6: result.DiffLineTypeContext,
+		},
+	}
+
+	assert.Equal(t, expected, parsePatchLineTypes([]byte(diffTestPatch)))
+}
+
+func TestDiffAnnotate(t *testing.T) {
+	patchPath := filepath.Join(t.TempDir(), "foo.patch")
+	require.NoError(t, os.WriteFile(patchPath, []byte(diffTestPatch), 0o600))
+
+	added := newIssueFromIssueTestCase(issueTestCase{Path: "foo.go", Line: 3, Linter: "linter"})
+	context := newIssueFromIssueTestCase(issueTestCase{Path: "foo.go", Line: 6, Linter: "linter"})
+	outside := newIssueFromIssueTestCase(issueTestCase{Path: "foo.go", Line: 10, Linter: "linter"})
+	otherFile := newIssueFromIssueTestCase(issueTestCase{Path: "bar.go", Line: 3, Linter: "linter"})
+
+	// filter-only by default.
+	out := process(t, NewDiff(false, "", patchPath, false, false), added, context, outside, otherFile)
+	if assert.Len(t, out, 1) {
+		assert.Equal(t, 3, out[0].Line())
+		assert.Empty(t, out[0].DiffLineType)
+	}
+
+	out = process(t, NewDiff(false, "", patchPath, false, true), added, context, outside, otherFile)
+	if assert.Len(t, out, 2) {
+		assert.Equal(t, 3, out[0].Line())
+		assert.Equal(t, result.DiffLineTypeAdded, out[0].DiffLineType)
+		assert.Equal(t, 6, out[1].Line())
+		assert.Equal(t, result.DiffLineTypeContext, out[1].DiffLineType)
+	}
+
+	out = process(t, NewDiff(false, "", patchPath, true, true), added, context, outside, otherFile)
+	if assert.Len(t, out, 3) {
+		assert.Equal(t, []string{result.DiffLineTypeAdded, result.DiffLineTypeContext, result.DiffLineTypeUnchanged},
+			[]string{out[0].DiffLineType, out[1].DiffLineType, out[2].DiffLineType})
+	}
+}
+
+func TestDiffWorkingTree(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repo := t.TempDir()
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(repo, name), []byte(content), 0o600))
+	}
+
+	git("init", "-q")
+	write("staged.go", "package foo\n\nfunc a() {}\n")
+	write("unstaged.go", "package foo\n\nfunc b() {}\n")
+	git("add", ".")
+	git("commit", "-q", "-m", "init")
+
+	write("staged.go", "package foo\n\nfunc a() {}\n\nfunc a2() {}\n")
+	git("add", "staged.go")
+	write("unstaged.go", "package foo\n\nfunc b() {}\n\nfunc b2() {}\n")
+	write("untracked.go", "package foo\n\nfunc c() {}\n")
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(repo))
+	defer func() { require.NoError(t, os.Chdir(wd)) }()
+
+	issues := []result.Issue{
+		newIssueFromIssueTestCase(issueTestCase{Path: "staged.go", Line: 3, Linter: "linter"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "staged.go", Line: 5, Linter: "linter"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "unstaged.go", Line: 3, Linter: "linter"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "unstaged.go", Line: 5, Linter: "linter"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "untracked.go", Line: 3, Linter: "linter"}),
+	}
+
+	positions := func(issues []result.Issue) []string {
+		var ret []string
+		for _, i := range issues {
+			ret = append(ret, fmt.Sprintf("%s:%d", i.FilePath(), i.Line()))
+		}
+		return ret
+	}
+
+	out := process(t, NewDiff(false, "", "", false, false).WithWorkingTree(true, false), issues...)
+	assert.Equal(t, []string{"staged.go:5", "unstaged.go:5", "untracked.go:3"}, positions(out))
+
+	out = process(t, NewDiff(false, "", "", false, false).WithWorkingTree(false, true), issues...)
+	assert.Equal(t, []string{"staged.go:5"}, positions(out))
+}
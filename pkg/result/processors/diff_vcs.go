@@ -0,0 +1,237 @@
+package processors
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/golangci/revgrep"
+)
+
+// vcs fetches from the version control system the changes made since a revision.
+type vcs interface {
+	// Patch returns the unified diff of the changes (nil if there is no repository)
+	// and the new files whose whole content must be considered as changed.
+	// If the revision is empty, the VCS detects the changes to use:
+	// uncommitted changes if any, else the changes of the last commit.
+	Patch(revisionFrom string) (io.Reader, []string, error)
+
+	// WorkingTreePatch returns the unified diff of the uncommitted changes (nil if there is no repository)
+	// and the untracked files, whose whole content must be considered as changed.
+	// If stagedOnly is set, only the staged changes are returned, without the untracked files.
+	WorkingTreePatch(stagedOnly bool) (io.Reader, []string, error)
+
+	// FileAtRevision returns the content of the file (relative to the current directory) at the revision,
+	// the boolean is false if the file doesn't exist at this revision.
+	FileAtRevision(revision, filePath string) ([]byte, bool, error)
+
+	// CurrentRevision returns the identifier of the revision checked out in the working directory.
+	CurrentRevision() (string, error)
+}
+
+type gitVCS struct{}
+
+func (gitVCS) Patch(revisionFrom string) (io.Reader, []string, error) {
+	patch, newFiles, err := revgrep.GitPatch(revisionFrom, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read git repo: %w", err)
+	}
+
+	return patch, newFiles, nil
+}
+
+func (v gitVCS) WorkingTreePatch(stagedOnly bool) (io.Reader, []string, error) {
+	if !stagedOnly {
+		// the staged and unstaged changes, and the untracked files.
+		return v.Patch("HEAD")
+	}
+
+	if err := exec.Command("git", "status", "--porcelain").Run(); err != nil {
+		return nil, nil, nil // not a repository
+	}
+
+	patch, err := exec.Command("git", "diff", "--color=never", "--relative", "--cached", "--").Output()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing git diff --cached: %w", err)
+	}
+
+	return bytes.NewReader(patch), nil, nil
+}
+
+func (gitVCS) FileAtRevision(revision, filePath string) ([]byte, bool, error) {
+	// the `./` prefix makes the path relative to the current directory.
+	path := "./" + filepath.ToSlash(filePath)
+
+	ls, err := exec.Command("git", "ls-tree", "--name-only", revision, "--", path).Output()
+	if err != nil {
+		return nil, false, fmt.Errorf("error executing git ls-tree %q: %w", revision, err)
+	}
+	if len(bytes.TrimSpace(ls)) == 0 {
+		return nil, false, nil
+	}
+
+	content, err := exec.Command("git", "show", revision+":"+path).Output()
+	if err != nil {
+		return nil, false, fmt.Errorf("error executing git show %q: %w", revision+":"+path, err)
+	}
+
+	return content, true, nil
+}
+
+func (gitVCS) CurrentRevision() (string, error) {
+	out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return "", fmt.Errorf("error executing git rev-parse HEAD: %w", err)
+	}
+
+	return string(bytes.TrimSpace(out)), nil
+}
+
+type hgVCS struct{}
+
+func (hgVCS) Patch(revisionFrom string) (io.Reader, []string, error) {
+	newFiles, err := hgUntrackedFiles()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if revisionFrom != "" {
+		patch, err := hgCommand(hgDiffArgs("-r", revisionFrom)...).Output()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error executing hg diff -r %q: %w", revisionFrom, err)
+		}
+
+		return bytes.NewReader(patch), newFiles, nil
+	}
+
+	// uncommitted changes
+	patch, err := hgCommand(hgDiffArgs()...).Output()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing hg diff: %w", err)
+	}
+
+	if len(patch) != 0 || newFiles != nil {
+		return bytes.NewReader(patch), newFiles, nil
+	}
+
+	// changes of the parent of the working directory
+	patch, err = hgCommand(hgDiffArgs("-c", ".")...).Output()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing hg diff -c .: %w", err)
+	}
+
+	return bytes.NewReader(patch), nil, nil
+}
+
+func (hgVCS) WorkingTreePatch(stagedOnly bool) (io.Reader, []string, error) {
+	if stagedOnly {
+		return nil, nil, errors.New("mercurial has no staged changes")
+	}
+
+	newFiles, err := hgUntrackedFiles()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	patch, err := hgCommand(hgDiffArgs()...).Output()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing hg diff: %w", err)
+	}
+
+	return bytes.NewReader(patch), newFiles, nil
+}
+
+// hgDiffArgs returns the arguments of hg diff making a patch as expected by revgrep:
+// --root makes the file paths relative to the current directory,
+// --git writes the headers without the dates after the file paths, as git does.
+func hgDiffArgs(args ...string) []string {
+	return append([]string{"diff", "--git", "--root", "."}, args...)
+}
+
+func hgUntrackedFiles() ([]string, error) {
+	status, err := hgCommand("status", "--unknown", "--no-status", ".").Output()
+	if err != nil {
+		return nil, fmt.Errorf("error executing hg status: %w", err)
+	}
+
+	var newFiles []string
+	for _, file := range bytes.Split(status, []byte{'\n'}) {
+		if len(file) != 0 {
+			newFiles = append(newFiles, string(file))
+		}
+	}
+
+	return newFiles, nil
+}
+
+func (hgVCS) FileAtRevision(revision, filePath string) ([]byte, bool, error) {
+	if err := hgCommand("files", "-r", revision, filePath).Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 { // no matching file
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("error executing hg files -r %q: %w", revision, err)
+	}
+
+	content, err := hgCommand("cat", "-r", revision, filePath).Output()
+	if err != nil {
+		return nil, false, fmt.Errorf("error executing hg cat -r %q: %w", revision, err)
+	}
+
+	return content, true, nil
+}
+
+func (hgVCS) CurrentRevision() (string, error) {
+	out, err := hgCommand("log", "-r", ".", "--template", "{node}").Output()
+	if err != nil {
+		return "", fmt.Errorf("error executing hg log -r .: %w", err)
+	}
+
+	return string(bytes.TrimSpace(out)), nil
+}
+
+func hgCommand(args ...string) *exec.Cmd {
+	cmd := exec.Command("hg", args...)
+	// HGPLAIN disables the user configuration altering the output (colors, aliases, etc.).
+	cmd.Env = append(os.Environ(), "HGPLAIN=1")
+	return cmd
+}
+
+// CurrentRevision returns the identifier of the revision checked out in the repository of the current directory.
+func CurrentRevision() (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("can't get working directory: %w", err)
+	}
+
+	return detectVCS(wd).CurrentRevision()
+}
+
+// detectVCS returns the VCS of the nearest repository containing the directory:
+// Mercurial if a .hg directory is found before a .git one, git otherwise.
+func detectVCS(dir string) vcs {
+	for {
+		if isDirOrFileExists(filepath.Join(dir, ".git")) {
+			return gitVCS{}
+		}
+
+		if isDirOrFileExists(filepath.Join(dir, ".hg")) {
+			return hgVCS{}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return gitVCS{}
+		}
+		dir = parent
+	}
+}
+
+func isDirOrFileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,73 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestDetectVCS(t *testing.T) {
+	root := t.TempDir()
+
+	gitRepo := filepath.Join(root, "git")
+	hgRepo := filepath.Join(gitRepo, "vendor", "hg")
+	hgSubDir := filepath.Join(hgRepo, "pkg", "foo")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(gitRepo, ".git"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(hgRepo, ".hg"), 0o755))
+	require.NoError(t, os.MkdirAll(hgSubDir, 0o755))
+
+	assert.Equal(t, gitVCS{}, detectVCS(gitRepo))
+	assert.Equal(t, gitVCS{}, detectVCS(filepath.Join(gitRepo, "vendor")))
+	assert.Equal(t, hgVCS{}, detectVCS(hgRepo))
+	assert.Equal(t, hgVCS{}, detectVCS(hgSubDir)) // the nearest repository wins
+}
+
+// hgTestPatch is a patch written by hg diff --git: a modified file and a new file.
+const hgTestPatch = `diff --git a/foo.go b/foo.go
+--- a/foo.go
++++ b/foo.go
+@@ -1,3 +1,4 @@
+ package foo
+ 
+ func foo() {}
++func bar() {}
+diff --git a/sub/new.go b/sub/new.go
+new file mode 100644
+--- /dev/null
++++ b/sub/new.go
+@@ -0,0 +1,2 @@
++package sub
++func baz() {}
+`
+
+func TestHgDiffArgs(t *testing.T) {
+	assert.Equal(t, []string{"diff", "--git", "--root", ".", "-r", "tip"}, hgDiffArgs("-r", "tip"))
+}
+
+func TestDiffHgPatch(t *testing.T) {
+	patchPath := filepath.Join(t.TempDir(), "hg.patch")
+	require.NoError(t, os.WriteFile(patchPath, []byte(hgTestPatch), 0o600))
+
+	added := newIssueFromIssueTestCase(issueTestCase{Path: "foo.go", Line: 4, Linter: "linter"})
+	context := newIssueFromIssueTestCase(issueTestCase{Path: "foo.go", Line: 3, Linter: "linter"})
+	newFile := newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join("sub", "new.go"), Line: 2, Linter: "linter"})
+
+	out := process(t, NewDiff(false, "", patchPath, false, false), added, context, newFile)
+	if assert.Len(t, out, 2) {
+		assert.Equal(t, "foo.go", out[0].FilePath())
+		assert.Equal(t, 4, out[0].Line())
+		assert.Equal(t, filepath.Join("sub", "new.go"), out[1].FilePath())
+	}
+
+	out = process(t, NewDiff(false, "", patchPath, false, true), added, context, newFile)
+	if assert.Len(t, out, 3) {
+		assert.Equal(t, []string{result.DiffLineTypeAdded, result.DiffLineTypeContext, result.DiffLineTypeAdded},
+			[]string{out[0].DiffLineType, out[1].DiffLineType, out[2].DiffLineType})
+	}
+}
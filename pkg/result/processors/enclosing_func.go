@@ -0,0 +1,152 @@
+package processors
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type funcRange struct {
+	from, to token.Position
+	name     string
+}
+
+// EnclosingFunc sets the name of the function or method declaration enclosing each issue.
+// Issues at package scope get an empty name.
+// The files of the issues are parsed again: the packages aren't loaded with their syntax.
+type EnclosingFunc struct {
+	enabled   bool
+	log       logutils.Log
+	fileCache map[string][]funcRange
+}
+
+var _ Processor = &EnclosingFunc{}
+
+func NewEnclosingFunc(enabled bool, log logutils.Log) *EnclosingFunc {
+	return &EnclosingFunc{
+		enabled:   enabled,
+		log:       log,
+		fileCache: map[string][]funcRange{},
+	}
+}
+
+func (p EnclosingFunc) Name() string {
+	return "enclosing_func"
+}
+
+func (p *EnclosingFunc) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		name := p.getEnclosingFunc(i)
+		if name == "" {
+			return i
+		}
+
+		newI := *i
+		newI.EnclosingFunc = name
+		return &newI
+	}), nil
+}
+
+func (p EnclosingFunc) Finish() {}
+
+func (p *EnclosingFunc) getEnclosingFunc(i *result.Issue) string {
+	if filepath.Ext(i.FilePath()) != ".go" {
+		return ""
+	}
+
+	ranges, ok := p.fileCache[i.FilePath()]
+	if !ok {
+		ranges = p.buildFuncRanges(i.FilePath())
+		p.fileCache[i.FilePath()] = ranges
+	}
+
+	for _, r := range ranges {
+		if isPositionInRange(i.Line(), i.Column(), r.from, r.to) {
+			return r.name
+		}
+	}
+
+	return ""
+}
+
+func (p *EnclosingFunc) buildFuncRanges(filePath string) []funcRange {
+	// Don't use cached AST because they consume a lot of memory on large projects.
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filePath, nil, 0)
+	if err != nil {
+		// the file can't be compiled either: typecheck reports the error.
+		p.log.Infof("Can't parse %s to find enclosing functions: %s", filePath, err)
+		return nil
+	}
+
+	var ranges []funcRange
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		ranges = append(ranges, funcRange{
+			from: fset.Position(fd.Pos()),
+			to:   fset.Position(fd.End()),
+			name: funcDeclName(fd),
+		})
+	}
+
+	return ranges
+}
+
+// funcDeclName returns the name of a function (`Foo`) or a method with its receiver type (`(*T).Foo`, `T.Foo`).
+func funcDeclName(fd *ast.FuncDecl) string {
+	if fd.Recv == nil || len(fd.Recv.List) == 0 {
+		return fd.Name.Name
+	}
+
+	recv := fd.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		return "(*" + recvTypeName(star.X) + ")." + fd.Name.Name
+	}
+
+	return recvTypeName(recv) + "." + fd.Name.Name
+}
+
+func recvTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.IndexExpr: // generic receiver: T[P]
+		return recvTypeName(e.X)
+	case *ast.IndexListExpr: // generic receiver: T[P1, P2]
+		return recvTypeName(e.X)
+	case *ast.ParenExpr:
+		return recvTypeName(e.X)
+	default:
+		return ""
+	}
+}
+
+// isPositionInRange reports whether the line and column are in [from, to).
+// A zero column means the column is unknown: only the line is compared.
+func isPositionInRange(line, col int, from, to token.Position) bool {
+	if line < from.Line || line > to.Line {
+		return false
+	}
+
+	if col == 0 {
+		return true
+	}
+
+	if line == from.Line && col < from.Column {
+		return false
+	}
+
+	return line != to.Line || col < to.Column
+}
@@ -0,0 +1,51 @@
+package processors
+
+import (
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestEnclosingFunc(t *testing.T) {
+	p := NewEnclosingFunc(true, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	testCases := []struct {
+		line, col int
+		expected  string
+	}{
+		{line: 3, col: 5},
+		{line: 6, col: 2, expected: "enclosingFunc"},
+		{line: 6, expected: "enclosingFunc"}, // unknown column
+		{line: 7, col: 2},                    // after the end of the function
+		{line: 12, col: 2, expected: "(*enclosingFuncType).pointerMethod"},
+		{line: 15, col: 30, expected: "enclosingFuncType.valueMethod"},
+		{line: 19, col: 40, expected: "enclosingFuncGeneric.genericMethod"},
+	}
+
+	for _, tc := range testCases {
+		issue := result.Issue{
+			Pos: token.Position{
+				Filename: filepath.Join("testdata", "enclosing_func.go"),
+				Line:     tc.line,
+				Column:   tc.col,
+			},
+		}
+
+		processed := process(t, p, issue)
+		assert.Len(t, processed, 1)
+		assert.Equal(t, tc.expected, processed[0].EnclosingFunc, "line %d, column %d", tc.line, tc.col)
+	}
+}
+
+func TestEnclosingFuncDisabled(t *testing.T) {
+	p := NewEnclosingFunc(false, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p, result.Issue{
+		Pos: token.Position{Filename: filepath.Join("testdata", "enclosing_func.go"), Line: 6, Column: 2},
+	})
+}
@@ -1,7 +1,9 @@
 package processors
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/logutils"
@@ -10,16 +12,73 @@ import (
 
 type excludeRule struct {
 	baseRule
+	checkIDs []string
+
+	warnIfUnused bool
+	desc         string
+}
+
+func (r *excludeRule) match(issue *result.Issue, lineCache *fsutils.LineCache, log logutils.Log) bool {
+	if len(r.checkIDs) != 0 && !r.matchCheckID(issue) {
+		return false
+	}
+
+	return r.baseRule.match(issue, lineCache, log)
+}
+
+// matchCheckID reports whether the check ID of the issue is one of the rule: the issues without one don't match.
+func (r *excludeRule) matchCheckID(issue *result.Issue) bool {
+	if issue.CheckID == "" {
+		return false
+	}
+
+	for _, checkID := range r.checkIDs {
+		if checkID == issue.CheckID {
+			return true
+		}
+	}
+
+	return false
 }
 
 type ExcludeRule struct {
 	BaseRule
+	CheckIDs []string
+
+	// WarnIfUnused warns in Finish if the rule matched no issue, e.g. for the rules of the configuration.
+	WarnIfUnused bool
+}
+
+// describe returns the conditions of the rule, to locate it in the configuration.
+func (r *ExcludeRule) describe() string {
+	var parts []string
+	if r.Path != "" {
+		parts = append(parts, fmt.Sprintf("path=%s", r.Path))
+	}
+	if len(r.Linters) != 0 {
+		parts = append(parts, fmt.Sprintf("linters=%s", strings.Join(r.Linters, ",")))
+	}
+	if len(r.CheckIDs) != 0 {
+		parts = append(parts, fmt.Sprintf("check-ids=%s", strings.Join(r.CheckIDs, ",")))
+	}
+	if r.Text != "" {
+		parts = append(parts, fmt.Sprintf("text=%s", r.Text))
+	}
+	if r.Source != "" {
+		parts = append(parts, fmt.Sprintf("source=%s", r.Source))
+	}
+
+	return strings.Join(parts, " ")
 }
 
 type ExcludeRules struct {
 	rules     []excludeRule
 	lineCache *fsutils.LineCache
 	log       logutils.Log
+
+	// hits are the counts of issues excluded by each rule:
+	// an issue matched by several rules is counted for the first one only.
+	hits []int
 }
 
 func NewExcludeRules(rules []ExcludeRule, lineCache *fsutils.LineCache, log logutils.Log) *ExcludeRules {
@@ -28,6 +87,7 @@ func NewExcludeRules(rules []ExcludeRule, lineCache *fsutils.LineCache, log logu
 		log:       log,
 	}
 	r.rules = createRules(rules, "(?i)")
+	r.hits = make([]int, len(r.rules))
 
 	return r
 }
@@ -37,6 +97,9 @@ func createRules(rules []ExcludeRule, prefix string) []excludeRule {
 	for _, rule := range rules {
 		parsedRule := excludeRule{}
 		parsedRule.linters = rule.Linters
+		parsedRule.checkIDs = rule.CheckIDs
+		parsedRule.warnIfUnused = rule.WarnIfUnused
+		parsedRule.desc = rule.describe()
 		if rule.Text != "" {
 			parsedRule.text = regexp.MustCompile(prefix + rule.Text)
 		}
@@ -57,9 +120,10 @@ func (p ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
 		return issues, nil
 	}
 	return filterIssues(issues, func(i *result.Issue) bool {
-		for _, rule := range p.rules {
+		for ind, rule := range p.rules {
 			rule := rule
 			if rule.match(i, p.lineCache, p.log) {
+				p.hits[ind]++
 				return false
 			}
 		}
@@ -68,7 +132,14 @@ func (p ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
 }
 
 func (ExcludeRules) Name() string { return "exclude-rules" }
-func (ExcludeRules) Finish()      {}
+
+func (p ExcludeRules) Finish() {
+	for ind, rule := range p.rules {
+		if rule.warnIfUnused && p.hits[ind] == 0 {
+			p.log.Warnf("exclude rule #%d [%s] matched 0 issues", ind, rule.desc)
+		}
+	}
+}
 
 var _ Processor = ExcludeRules{}
 
@@ -82,6 +153,7 @@ func NewExcludeRulesCaseSensitive(rules []ExcludeRule, lineCache *fsutils.LineCa
 		log:       log,
 	}
 	r.rules = createRules(rules, "")
+	r.hits = make([]int, len(r.rules))
 
 	return &ExcludeRulesCaseSensitive{r}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
@@ -198,3 +199,48 @@ func TestExcludeRulesCaseSensitiveText(t *testing.T) {
 func TestExcludeRulesCaseSensitiveEmpty(t *testing.T) {
 	processAssertSame(t, NewExcludeRulesCaseSensitive(nil, nil, nil), newIssueFromTextTestCase("test"))
 }
+
+func TestExcludeRulesCheckIDs(t *testing.T) {
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	p := NewExcludeRules([]ExcludeRule{
+		{
+			BaseRule: BaseRule{Linters: []string{"staticcheck"}},
+			CheckIDs: []string{"SA1019", "SA9003"},
+		},
+	}, lineCache, nil)
+
+	newIssue := func(linter, checkID, text string) result.Issue {
+		i := newIssueFromIssueTestCase(issueTestCase{Path: "e.go", Linter: linter, Text: text})
+		i.CheckID = checkID
+		return i
+	}
+
+	deprecated := newIssue("staticcheck", "SA1019", "SA1019: foo is deprecated")
+	other := newIssue("staticcheck", "SA4006", "SA4006: this value of x is never used")
+	otherLinter := newIssue("stylecheck", "SA1019", "SA1019: not a staticcheck issue")
+	withoutCheckID := newIssue("staticcheck", "", "SA1019: foo is deprecated")
+
+	processAssertEmpty(t, p, deprecated)
+	processAssertSame(t, p, other, otherLinter, withoutCheckID)
+}
+
+func TestExcludeRulesWarnUnused(t *testing.T) {
+	log := logutils.NewMockLog()
+	log.On("Warnf", "exclude rule #%d [%s] matched 0 issues", 1, `path=_test\.go linters=gocyclo`)
+	log.On("Warnf", "exclude rule #%d [%s] matched 0 issues", 2, "text=shadowed")
+
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	p := NewExcludeRules([]ExcludeRule{
+		{BaseRule: BaseRule{Text: "^exclude"}, WarnIfUnused: true},
+		{BaseRule: BaseRule{Path: `_test\.go`, Linters: []string{"gocyclo"}}, WarnIfUnused: true},
+		// an issue matched by several rules is counted for the first one only.
+		{BaseRule: BaseRule{Text: "shadowed"}, WarnIfUnused: true},
+		// a default exclude.
+		{BaseRule: BaseRule{Text: "^default"}},
+	}, lineCache, log)
+
+	processAssertEmpty(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "e.go", Linter: "govet", Text: "exclude shadowed"}))
+	p.Finish()
+
+	log.AssertExpectations(t)
+}
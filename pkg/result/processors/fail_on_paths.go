@@ -0,0 +1,37 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// FailOnPaths marks the issues outside the paths matching the globs (see result.Issue.NotFailing):
+// these issues are reported, but they don't set the exit code.
+// The globs match the paths relative to the current directory, as PathGlobFilter.
+type FailOnPaths struct {
+	filter *PathGlobFilter
+}
+
+var _ Processor = FailOnPaths{}
+
+// NewFailOnPaths returns a processor marking the issues outside the paths matching the globs.
+// No globs means no marked issue.
+func NewFailOnPaths(globs []string) *FailOnPaths {
+	return &FailOnPaths{filter: NewPathGlobFilter(globs)}
+}
+
+func (p FailOnPaths) Name() string {
+	return "fail_on_paths"
+}
+
+func (p FailOnPaths) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.filter.globs) == 0 {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		i.NotFailing = !p.filter.matches(i)
+		return i
+	}), nil
+}
+
+func (p FailOnPaths) Finish() {}
@@ -0,0 +1,27 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailOnPaths(t *testing.T) {
+	p := NewFailOnPaths([]string{"newcode/**"})
+
+	issues := process(t, p,
+		newIssueFromIssueTestCase(issueTestCase{Path: "newcode/a.go", Line: 1}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "oldcode/a.go", Line: 1}))
+
+	// all the issues are kept: the path prefix added later doesn't change the marks.
+	issues = process(t, NewPathPrefixer("module"), issues...)
+	assert.Len(t, issues, 2)
+	assert.False(t, issues[0].NotFailing)
+	assert.True(t, issues[1].NotFailing)
+}
+
+func TestFailOnPathsNoGlobs(t *testing.T) {
+	p := NewFailOnPaths(nil)
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "oldcode/a.go", Line: 1}))
+}
@@ -0,0 +1,90 @@
+package processors
+
+import (
+	"os"
+	"time"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// FileModified keeps only the issues of the files modified (according to their mtime) after and/or before dates:
+// e.g. to ignore the files generated by the build, or to focus on the old files.
+// The issues of the files which can't be stat'ed are kept.
+type FileModified struct {
+	after  time.Time // zero: no lower bound
+	before time.Time // zero: no upper bound
+	log    logutils.Log
+
+	modTimes map[string]*time.Time // nil value: the file can't be stat'ed
+}
+
+var _ Processor = &FileModified{}
+
+// NewFileModified returns a processor for dates like `2024-01-01` or `2024-01-01T15:04:05Z`.
+// An empty date disables its bound.
+func NewFileModified(after, before string, log logutils.Log) (*FileModified, error) {
+	p := &FileModified{
+		log:      log,
+		modTimes: map[string]*time.Time{},
+	}
+
+	var err error
+	if after != "" {
+		if p.after, err = parseBlameDate(after); err != nil {
+			return nil, err
+		}
+	}
+
+	if before != "" {
+		if p.before, err = parseBlameDate(before); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+func (p FileModified) Name() string {
+	return "file_modified"
+}
+
+func (p *FileModified) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.after.IsZero() && p.before.IsZero() { // no need to work
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		modTime := p.getModTime(i.FilePath())
+		if modTime == nil {
+			return true
+		}
+
+		if !p.after.IsZero() && !modTime.After(p.after) {
+			return false
+		}
+
+		return p.before.IsZero() || modTime.Before(p.before)
+	}), nil
+}
+
+func (p FileModified) Finish() {}
+
+func (p *FileModified) getModTime(filePath string) *time.Time {
+	modTime, ok := p.modTimes[filePath]
+	if ok {
+		return modTime
+	}
+
+	// the file is stat'ed once for all its issues.
+	fi, err := os.Stat(filePath)
+	if err != nil {
+		p.log.Infof("Can't stat %s, issues of this file aren't filtered by modification time: %s", filePath, err)
+	} else {
+		t := fi.ModTime()
+		modTime = &t
+	}
+
+	p.modTimes[filePath] = modTime
+	return modTime
+}
@@ -0,0 +1,59 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestFileModified(t *testing.T) {
+	dir := t.TempDir()
+
+	oldFile := filepath.Join(dir, "old.go")
+	newFile := filepath.Join(dir, "new.go")
+	for file, modTime := range map[string]time.Time{
+		oldFile: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+		newFile: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		require.NoError(t, os.WriteFile(file, []byte("package p\n"), 0o600))
+		require.NoError(t, os.Chtimes(file, modTime, modTime))
+	}
+
+	oldIssue := newBlameAuthorsTestIssue(oldFile, 1)
+	newIssue := newBlameAuthorsTestIssue(newFile, 1)
+	missingIssue := newBlameAuthorsTestIssue(filepath.Join(dir, "missing.go"), 1)
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+
+	p, err := NewFileModified("2024-01-01", "", log)
+	require.NoError(t, err)
+	processAssertEmpty(t, p, oldIssue)
+	processAssertSame(t, p, newIssue, missingIssue)
+
+	p, err = NewFileModified("", "2024-01-01", log)
+	require.NoError(t, err)
+	processAssertEmpty(t, p, newIssue)
+	processAssertSame(t, p, oldIssue, missingIssue)
+
+	p, err = NewFileModified("2023-01-01", "2024-01-01T00:00:00Z", log)
+	require.NoError(t, err)
+	processAssertEmpty(t, p, newIssue)
+	processAssertSame(t, p, oldIssue)
+}
+
+func TestFileModifiedDisabled(t *testing.T) {
+	p, err := NewFileModified("", "", logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newBlameAuthorsTestIssue("missing.go", 1))
+}
+
+func TestFileModifiedInvalidDate(t *testing.T) {
+	_, err := NewFileModified("", "01/01/2024", logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package processors
 
 import (
+	"go/ast"
 	"go/parser"
 	"go/token"
 	"path/filepath"
@@ -18,14 +19,19 @@ type posMapper func(pos token.Position) token.Position
 
 type adjustMap struct {
 	sync.Mutex
-	m map[string]posMapper
+	m              map[string]posMapper
+	lineDirectives map[string]posMapper // nil: the //line directives aren't followed
 }
 
 // FilenameUnadjuster is needed because a lot of linters use fset.Position(f.Pos())
 // to get filename. And they return adjusted filename (e.g. *.qtpl) for an issue. We need
 // restore real .go filename to properly output it, parse it, etc.
+//
+// Optionally, it then follows the //line directives the other way around: the issues of the .go files
+// are reported at the file and line of the original source (e.g. the template).
 type FilenameUnadjuster struct {
 	m                   map[string]posMapper // map from adjusted filename to position mapper: adjusted -> unadjusted position
+	lineDirectives      map[string]posMapper // map from .go filename to position mapper: unadjusted -> adjusted position
 	log                 logutils.Log
 	loggedUnadjustments map[string]bool
 }
@@ -48,6 +54,10 @@ func processUnadjusterFile(filename string, m *adjustMap, log logutils.Log, fset
 		return
 	}
 
+	if m.lineDirectives != nil && hasLineDirectives(syntax) {
+		addLineDirectivesMapper(filename, syntax, m, fset)
+	}
+
 	adjustedFilename := fset.PositionFor(syntax.Pos(), true).Filename
 	if adjustedFilename == "" {
 		return
@@ -74,8 +84,57 @@ func processUnadjusterFile(filename string, m *adjustMap, log logutils.Log, fset
 	}
 }
 
-func NewFilenameUnadjuster(pkgs []*packages.Package, log logutils.Log) *FilenameUnadjuster {
+func hasLineDirectives(syntax *ast.File) bool {
+	for _, group := range syntax.Comments {
+		for _, comment := range group.List {
+			if strings.HasPrefix(comment.Text, "//line ") || strings.HasPrefix(comment.Text, "/*line ") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// addLineDirectivesMapper maps the positions of the .go file to the positions set by its //line directives.
+// The issues can have no offset (e.g. from line-based linters): the position is computed from the line and the column.
+func addLineDirectivesMapper(filename string, syntax *ast.File, m *adjustMap, fset *token.FileSet) {
+	if !strings.HasSuffix(filename, ".go") {
+		return
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.lineDirectives[filename] = func(pos token.Position) token.Position {
+		tokenFile := fset.File(syntax.Pos())
+		if tokenFile == nil || pos.Line < 1 || pos.Line > tokenFile.LineCount() {
+			return pos
+		}
+
+		p := tokenFile.LineStart(pos.Line)
+		if pos.Column > 1 {
+			offset := tokenFile.Offset(p) + pos.Column - 1
+			if offset > tokenFile.Size() {
+				offset = tokenFile.Size()
+			}
+			p = tokenFile.Pos(offset)
+		}
+
+		adjustedPos := fset.PositionFor(p, true)
+		if pos.Column == 0 {
+			adjustedPos.Column = 0 // unknown
+		}
+		return adjustedPos
+	}
+}
+
+// NewFilenameUnadjuster returns the processor restoring the .go filenames of the issues.
+// If followLineDirectives is set, the issues are then reported at the positions set by the //line directives.
+func NewFilenameUnadjuster(pkgs []*packages.Package, log logutils.Log, followLineDirectives bool) *FilenameUnadjuster {
 	m := adjustMap{m: map[string]posMapper{}}
+	if followLineDirectives {
+		m.lineDirectives = map[string]posMapper{}
+	}
 
 	startedAt := time.Now()
 	var wg sync.WaitGroup
@@ -88,10 +147,11 @@ func NewFilenameUnadjuster(pkgs []*packages.Package, log logutils.Log) *Filename
 		}(pkg)
 	}
 	wg.Wait()
-	log.Infof("Pre-built %d adjustments in %s", len(m.m), time.Since(startedAt))
+	log.Infof("Pre-built %d adjustments in %s", len(m.m)+len(m.lineDirectives), time.Since(startedAt))
 
 	return &FilenameUnadjuster{
 		m:                   m.m,
+		lineDirectives:      m.lineDirectives,
 		log:                 log,
 		loggedUnadjustments: map[string]bool{},
 	}
@@ -113,19 +173,53 @@ func (p *FilenameUnadjuster) Process(issues []result.Issue) ([]result.Issue, err
 			issueFilePath = absPath
 		}
 
-		mapper := p.m[issueFilePath]
-		if mapper == nil {
+		pos := i.Pos
+		if mapper := p.m[issueFilePath]; mapper != nil {
+			pos = mapper(i.Pos)
+			if !p.loggedUnadjustments[i.Pos.Filename] {
+				p.log.Infof("Unadjusted from %v to %v", i.Pos, pos)
+				p.loggedUnadjustments[i.Pos.Filename] = true
+			}
+
+			// the unadjusted filename is absolute.
+			issueFilePath = pos.Filename
+		}
+
+		endPos := i.EndPos
+		if mapper := p.lineDirectives[issueFilePath]; mapper != nil {
+			mappedPos := mapper(pos)
+			endPos = mapEndPosition(mapper, pos.Filename, mappedPos.Filename, i.EndPos)
+			pos = mappedPos
+		}
+
+		if pos == i.Pos && endPos == i.EndPos {
 			return i
 		}
 
 		newI := *i
-		newI.Pos = mapper(i.Pos)
-		if !p.loggedUnadjustments[i.Pos.Filename] {
-			p.log.Infof("Unadjusted from %v to %v", i.Pos, newI.Pos)
-			p.loggedUnadjustments[i.Pos.Filename] = true
-		}
+		newI.Pos = pos
+		newI.EndPos = endPos
 		return &newI
 	}), nil
 }
 
 func (FilenameUnadjuster) Finish() {}
+
+// mapEndPosition maps the end of the range of an issue with the //line directives mapper:
+// the end is dropped if it's mapped to another file than the start.
+func mapEndPosition(mapper posMapper, filename, mappedFilename string, endPos *result.EndPosition) *result.EndPosition {
+	if endPos == nil {
+		return nil
+	}
+
+	mapped := mapper(token.Position{Filename: filename, Line: endPos.Line, Column: endPos.Column})
+	if mapped.Filename != mappedFilename {
+		return nil
+	}
+
+	if mapped.Line == endPos.Line && mapped.Column == endPos.Column {
+		return endPos
+	}
+
+	return &result.EndPosition{Line: mapped.Line, Column: mapped.Column}
+}
@@ -0,0 +1,60 @@
+package processors
+
+import (
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newLineDirectivesTestUnadjuster(t *testing.T, follow bool) (*FilenameUnadjuster, string) {
+	t.Helper()
+
+	filename, err := filepath.Abs(filepath.Join("testdata", "line_directives.go"))
+	require.NoError(t, err)
+
+	pkgs := []*packages.Package{{CompiledGoFiles: []string{filename}}}
+
+	return NewFilenameUnadjuster(pkgs, logutils.NewStderrLog(logutils.DebugKeyEmpty), follow), filename
+}
+
+func TestFilenameUnadjusterLineDirectives(t *testing.T) {
+	p, filename := newLineDirectivesTestUnadjuster(t, true)
+	template := filepath.Join(filepath.Dir(filename), "template.tmpl")
+
+	issues, err := p.Process([]result.Issue{
+		{Text: "before", Pos: token.Position{Filename: filename, Line: 1, Column: 1}},
+		{Text: "func", Pos: token.Position{Filename: filename, Line: 4, Column: 6}},
+		{Text: "var", Pos: token.Position{Filename: filename, Line: 5}},
+		{Text: "bar", Pos: token.Position{Filename: filename, Line: 10, Column: 6}},
+		{Text: "other", Pos: token.Position{Filename: "other.go", Line: 5}},
+	})
+	require.NoError(t, err)
+
+	var positions []token.Position
+	for _, i := range issues {
+		positions = append(positions, token.Position{Filename: i.FilePath(), Line: i.Line(), Column: i.Column()})
+	}
+
+	expected := []token.Position{
+		{Filename: filename, Line: 1, Column: 1},
+		{Filename: template, Line: 10}, // the directive has no column: it's unknown
+		{Filename: template, Line: 11},
+		{Filename: template, Line: 20, Column: 8},
+		{Filename: "other.go", Line: 5},
+	}
+	assert.Equal(t, expected, positions)
+}
+
+func TestFilenameUnadjusterLineDirectivesDisabled(t *testing.T) {
+	p, filename := newLineDirectivesTestUnadjuster(t, false)
+
+	issue := result.Issue{Text: "func", Pos: token.Position{Filename: filename, Line: 4, Column: 6}}
+	processAssertSame(t, p, issue)
+}
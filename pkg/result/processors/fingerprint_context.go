@@ -0,0 +1,116 @@
+package processors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// fingerprintContextRadius is the count of non-blank lines before and after the issue line used in the fingerprint.
+const fingerprintContextRadius = 2
+
+// FingerprintContext sets the normalized code surrounding each issue,
+// to compute fingerprints not depending on line numbers in the `content` fingerprint mode.
+type FingerprintContext struct {
+	enabled   bool
+	lineCache *fsutils.LineCache
+	log       logutils.Log
+}
+
+var _ Processor = (*FingerprintContext)(nil)
+
+func NewFingerprintContext(mode string, lineCache *fsutils.LineCache, log logutils.Log) (*FingerprintContext, error) {
+	switch mode {
+	case "", config.FingerprintModePosition, config.FingerprintModeContent:
+	default:
+		return nil, fmt.Errorf("unknown fingerprint mode %q: expected %s or %s",
+			mode, config.FingerprintModePosition, config.FingerprintModeContent)
+	}
+
+	return &FingerprintContext{
+		enabled:   mode == config.FingerprintModeContent,
+		lineCache: lineCache,
+		log:       log,
+	}, nil
+}
+
+func (p FingerprintContext) Name() string {
+	return "fingerprint_context"
+}
+
+func (p FingerprintContext) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		context, err := p.getContext(i)
+		if err != nil {
+			p.log.Warnf("Failed to get the code surrounding the issue %s:%d: %s", i.FilePath(), i.Line(), err)
+			return i
+		}
+
+		newI := *i
+		newI.FingerprintContext = context
+		return &newI
+	}), nil
+}
+
+// getContext returns the issue line with the non-blank lines around it, whitespaces normalized:
+// formatting changes and blank lines don't change the fingerprint.
+func (p FingerprintContext) getContext(i *result.Issue) ([]string, error) {
+	linesCount, err := p.lineCache.GetLinesCount(i.FilePath())
+	if err != nil {
+		return nil, err
+	}
+
+	issueLine := i.Line()
+	if issueLine == 0 {
+		issueLine = 1
+	}
+
+	getNormalizedLine := func(lineNumber int) (string, error) {
+		line, err := p.lineCache.GetLine(i.FilePath(), lineNumber)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(strings.Fields(line), " "), nil
+	}
+
+	var before []string
+	for lineNumber := issueLine - 1; lineNumber >= 1 && len(before) < fingerprintContextRadius; lineNumber-- {
+		line, err := getNormalizedLine(lineNumber)
+		if err != nil {
+			return nil, err
+		}
+		if line != "" {
+			before = append([]string{line}, before...)
+		}
+	}
+
+	line, err := getNormalizedLine(issueLine)
+	if err != nil {
+		return nil, err
+	}
+	context := append(before, line)
+
+	var after int
+	for lineNumber := issueLine + 1; lineNumber <= linesCount && after < fingerprintContextRadius; lineNumber++ {
+		line, err := getNormalizedLine(lineNumber)
+		if err != nil {
+			return nil, err
+		}
+		if line != "" {
+			context = append(context, line)
+			after++
+		}
+	}
+
+	return context, nil
+}
+
+func (p FingerprintContext) Finish() {}
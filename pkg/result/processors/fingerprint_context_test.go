@@ -0,0 +1,71 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func getContentFingerprint(t *testing.T, content string, line int) (string, []string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "foo.go")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	p, err := NewFingerprintContext("content", fsutils.NewLineCache(fsutils.NewFileCache()),
+		logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	processed := process(t, p, result.Issue{
+		FromLinter: "errcheck",
+		Text:       "Error return value is not checked",
+		Pos:        token.Position{Filename: path, Line: line},
+	})
+	require.Len(t, processed, 1)
+
+	// the fingerprint must not depend on the temporary directory
+	issue := processed[0]
+	issue.Pos.Filename = "foo.go"
+
+	return issue.Fingerprint(), issue.FingerprintContext
+}
+
+func TestFingerprintContext(t *testing.T) {
+	const content = "package foo\n\nfunc a() {}\n\nfunc foo() {\n\tbar()\n\n\tbaz()\n}\n"
+
+	fingerprint, context := getContentFingerprint(t, content, 6)
+	assert.Equal(t, []string{"func a() {}", "func foo() {", "bar()", "baz()", "}"}, context)
+
+	// lines added above the issue
+	shifted, _ := getContentFingerprint(t, strings.Replace(content, "\n\n", "\n\nimport \"fmt\"\n\nvar _ = fmt.Sprint\n\n", 1), 10)
+	assert.Equal(t, fingerprint, shifted)
+
+	// code reformatted around the issue
+	reformatted, _ := getContentFingerprint(t, "package foo\n\nfunc a() {}\nfunc foo()  {\n    bar()\n\tbaz()\n}\n", 5)
+	assert.Equal(t, fingerprint, reformatted)
+
+	// code changed around the issue
+	changed, _ := getContentFingerprint(t, strings.Replace(content, "baz()", "qux()", 1), 6)
+	assert.NotEqual(t, fingerprint, changed)
+}
+
+func TestFingerprintContextPositionMode(t *testing.T) {
+	p, err := NewFingerprintContext("position", nil, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	processAssertSame(t, p, result.Issue{Pos: token.Position{Filename: "foo.go", Line: 1}})
+}
+
+func TestFingerprintContextInvalidMode(t *testing.T) {
+	_, err := NewFingerprintContext("line", nil, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	assert.Error(t, err)
+}
@@ -0,0 +1,190 @@
+package processors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gofrs/flock"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const (
+	firstSeenDateLayout = "2006-01-02"
+
+	firstSeenLockTimeout    = time.Minute
+	firstSeenLockRetryDelay = 100 * time.Millisecond
+)
+
+// FirstSeen sets the date when the fingerprint of each issue (see result.Issue.Fingerprint) was first seen,
+// e.g. for the dashboards of the age of the technical debt.
+// The dates are kept in a store: a JSON object of the dates (YYYY-MM-DD) by fingerprint.
+// The fingerprints not in the store are seen today: they are added to the store.
+// The fingerprints no longer reported are kept, the issues can be reported again.
+// The store is read, updated and written under a lock (a `.lock` file next to it): the concurrent runs share it.
+type FirstSeen struct {
+	storePath string
+	log       logutils.Log
+
+	// deferSave keeps the dates of the fingerprints added by the last Process in added, until Save:
+	// the store is written once for the runs processing the issues several times (e.g. to confirm the fixes).
+	deferSave bool
+	added     map[string]string
+
+	now func() time.Time
+}
+
+var _ Processor = (*FirstSeen)(nil)
+
+// NewFirstSeen returns a processor setting the first seen dates from the store.
+// No store path means no dates.
+func NewFirstSeen(storePath string, log logutils.Log) *FirstSeen {
+	return &FirstSeen{
+		storePath: storePath,
+		log:       log,
+		now:       time.Now,
+	}
+}
+
+// DeferSave makes Process keep the added fingerprints instead of writing them: they are written by Save.
+func (p *FirstSeen) DeferSave() {
+	p.deferSave = true
+}
+
+func (p *FirstSeen) Name() string {
+	return "first_seen"
+}
+
+func (p *FirstSeen) Process(issues []result.Issue) ([]result.Issue, error) {
+	p.added = nil
+	if p.storePath == "" || len(issues) == 0 {
+		return issues, nil
+	}
+
+	err := p.withLock(func() error {
+		dates, err := readFirstSeenStore(p.storePath)
+		if err != nil {
+			return err
+		}
+
+		today := p.now().Format(firstSeenDateLayout)
+		p.added = map[string]string{}
+
+		issues = transformIssues(issues, func(i *result.Issue) *result.Issue {
+			fingerprint := i.Fingerprint()
+
+			date, ok := dates[fingerprint]
+			if !ok {
+				date = today
+				dates[fingerprint] = date
+				p.added[fingerprint] = date
+			}
+
+			newI := *i
+			newI.FirstSeen = date
+			return &newI
+		})
+
+		if p.deferSave {
+			return nil
+		}
+
+		return p.writeStore(dates)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
+func (p *FirstSeen) Finish() {}
+
+// Save writes the fingerprints added by the last Process to the store, if the save is deferred (see DeferSave).
+// The store is read again: the fingerprints added meanwhile by the concurrent runs are kept.
+func (p *FirstSeen) Save() error {
+	if !p.deferSave || len(p.added) == 0 {
+		return nil
+	}
+
+	return p.withLock(func() error {
+		dates, err := readFirstSeenStore(p.storePath)
+		if err != nil {
+			return err
+		}
+
+		for fingerprint, date := range p.added {
+			if _, ok := dates[fingerprint]; !ok {
+				dates[fingerprint] = date
+			}
+		}
+
+		return p.writeStore(dates)
+	})
+}
+
+// withLock runs f with the lock of the store.
+func (p *FirstSeen) withLock(f func() error) error {
+	lock := flock.New(p.storePath + ".lock")
+
+	ctx, cancel := context.WithTimeout(context.Background(), firstSeenLockTimeout)
+	defer cancel()
+
+	if ok, err := lock.TryLockContext(ctx, firstSeenLockRetryDelay); !ok {
+		return fmt.Errorf("can't lock the first seen store %s: %v", p.storePath, err)
+	}
+
+	defer func() {
+		if err := lock.Unlock(); err != nil {
+			p.log.Warnf("Failed to unlock the first seen store %s: %s", p.storePath, err)
+		}
+	}()
+
+	return f()
+}
+
+// writeStore writes the dates to the store if fingerprints were added.
+func (p *FirstSeen) writeStore(dates map[string]string) error {
+	if len(p.added) == 0 {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(dates, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal the first seen store: %w", err)
+	}
+
+	if err := fsutils.WriteFileAtomic(p.storePath, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write the first seen store %s: %w", p.storePath, err)
+	}
+
+	p.log.Infof("Added %d fingerprints seen on %s to the first seen store %s",
+		len(p.added), p.now().Format(firstSeenDateLayout), p.storePath)
+
+	return nil
+}
+
+// readFirstSeenStore returns the dates of the store by fingerprint, none if the store doesn't exist yet.
+func readFirstSeenStore(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return map[string]string{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to read the first seen store: %w", err)
+	}
+
+	dates := map[string]string{}
+	if err := json.Unmarshal(data, &dates); err != nil {
+		return nil, fmt.Errorf("failed to parse the first seen store %s: %w", path, err)
+	}
+
+	return dates, nil
+}
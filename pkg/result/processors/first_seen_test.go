@@ -0,0 +1,92 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestFirstSeen(t *testing.T) {
+	storePath := filepath.Join(t.TempDir(), "first-seen.json")
+
+	known := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Text: "known", Linter: "linter"})
+	added := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Text: "added", Linter: "linter"})
+
+	err := os.WriteFile(storePath, []byte(`{"`+known.Fingerprint()+`": "2020-01-02"}`), 0o600)
+	require.NoError(t, err)
+
+	log := logutils.NewMockLog()
+	log.On("Infof", "Added %d fingerprints seen on %s to the first seen store %s", 1, "2023-04-05", storePath)
+
+	p := NewFirstSeen(storePath, log)
+	p.now = func() time.Time { return time.Date(2023, 4, 5, 10, 0, 0, 0, time.Local) }
+
+	processed := process(t, p, known, added)
+	require.Len(t, processed, 2)
+	assert.Equal(t, "2020-01-02", processed[0].FirstSeen)
+	assert.Equal(t, "2023-04-05", processed[1].FirstSeen)
+
+	dates, err := readFirstSeenStore(storePath)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{known.Fingerprint(): "2020-01-02", added.Fingerprint(): "2023-04-05"}, dates)
+
+	// the dates of the store are kept on the next runs.
+	p.now = func() time.Time { return time.Date(2023, 5, 6, 10, 0, 0, 0, time.Local) }
+
+	processed = process(t, p, added)
+	require.Len(t, processed, 1)
+	assert.Equal(t, "2023-04-05", processed[0].FirstSeen)
+}
+
+func TestFirstSeenDeferSave(t *testing.T) {
+	storePath := filepath.Join(t.TempDir(), "first-seen.json")
+
+	fixed := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Text: "fixed", Linter: "linter"})
+	left := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Text: "left", Linter: "linter"})
+
+	log := logutils.NewMockLog()
+	log.On("Infof", "Added %d fingerprints seen on %s to the first seen store %s", 1, "2023-04-05", storePath)
+
+	p := NewFirstSeen(storePath, log)
+	p.now = func() time.Time { return time.Date(2023, 4, 5, 10, 0, 0, 0, time.Local) }
+	p.DeferSave()
+
+	processed := process(t, p, fixed, left)
+	require.Len(t, processed, 2)
+	assert.Equal(t, "2023-04-05", processed[0].FirstSeen)
+	assert.NoFileExists(t, storePath)
+
+	// only the fingerprints of the last processing are saved.
+	processed = process(t, p, left)
+	require.Len(t, processed, 1)
+	assert.Equal(t, "2023-04-05", processed[0].FirstSeen)
+
+	require.NoError(t, p.Save())
+
+	dates, err := readFirstSeenStore(storePath)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{left.Fingerprint(): "2023-04-05"}, dates)
+}
+
+func TestFirstSeenInvalidStore(t *testing.T) {
+	storePath := filepath.Join(t.TempDir(), "first-seen.json")
+	require.NoError(t, os.WriteFile(storePath, []byte("not json"), 0o600))
+
+	p := NewFirstSeen(storePath, logutils.NewMockLog())
+
+	_, err := p.Process([]result.Issue{newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Text: "issue"})})
+	assert.Error(t, err)
+}
+
+func TestFirstSeenDisabled(t *testing.T) {
+	p := NewFirstSeen("", logutils.NewMockLog())
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Text: "issue"}))
+}
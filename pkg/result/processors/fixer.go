@@ -3,8 +3,12 @@ package processors
 import (
 	"bytes"
 	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -23,14 +27,21 @@ type Fixer struct {
 	log       logutils.Log
 	fileCache *fsutils.FileCache
 	sw        *timeutils.Stopwatch
+
+	fixedFiles []string
 }
 
 func NewFixer(cfg *config.Config, log logutils.Log, fileCache *fsutils.FileCache) *Fixer {
+	swLog := log
+	if cfg.Run.NoTiming {
+		swLog = logutils.NewDiscardLog()
+	}
+
 	return &Fixer{
 		cfg:       cfg,
 		log:       log,
 		fileCache: fileCache,
-		sw:        timeutils.NewStopwatch("fixer", log),
+		sw:        timeutils.NewStopwatch("fixer", swLog),
 	}
 }
 
@@ -38,7 +49,7 @@ func (f Fixer) printStat() {
 	f.sw.PrintStages()
 }
 
-func (f Fixer) Process(issues []result.Issue) []result.Issue {
+func (f *Fixer) Process(issues []result.Issue) []result.Issue {
 	if !f.cfg.Issues.NeedFix {
 		return issues
 	}
@@ -65,13 +76,24 @@ func (f Fixer) Process(issues []result.Issue) []result.Issue {
 
 			// show issues only if can't fix them
 			outIssues = append(outIssues, issuesToFix...)
+			continue
 		}
+
+		f.fixedFiles = append(f.fixedFiles, file)
+		f.fileCache.Forget(file)
 	}
 
+	sort.Strings(f.fixedFiles)
+
 	f.printStat()
 	return outIssues
 }
 
+// FixedFiles returns the sorted paths of the files fixed by Process: their cached content is forgotten.
+func (f *Fixer) FixedFiles() []string {
+	return f.fixedFiles
+}
+
 func (f Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
 	// TODO: don't read the whole file into memory: read line by line;
 	// can't just use bufio.scanner: it has a line length limit
@@ -81,12 +103,6 @@ func (f Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
 	}
 	origFileLines := bytes.Split(origFileData, []byte("\n"))
 
-	tmpFileName := filepath.Join(filepath.Dir(filePath), fmt.Sprintf(".%s.golangci_fix", filepath.Base(filePath)))
-	tmpOutFile, err := os.Create(tmpFileName)
-	if err != nil {
-		return errors.Wrapf(err, "failed to make file %s", tmpFileName)
-	}
-
 	// merge multiple issues per line into one issue
 	issuesPerLine := map[int][]result.Issue{}
 	for i := range issues {
@@ -94,61 +110,164 @@ func (f Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
 		issuesPerLine[issue.Line()] = append(issuesPerLine[issue.Line()], *issue)
 	}
 
-	issues = issues[:0] // reuse the same memory
+	// not the memory of the issues: on an error, the caller reports them.
+	issues = make([]result.Issue, 0, len(issuesPerLine))
 	for line, lineIssues := range issuesPerLine {
-		if mergedIssue := f.mergeLineIssues(line, lineIssues, origFileLines); mergedIssue != nil {
+		mergedIssue, err := f.mergeLineIssues(line, lineIssues, origFileLines)
+		if err != nil {
+			return err
+		}
+
+		if mergedIssue != nil {
 			issues = append(issues, *mergedIssue)
 		}
 	}
 
-	issues = f.findNotIntersectingIssues(issues)
+	issues, err = f.findNotIntersectingIssues(issues)
+	if err != nil {
+		return err
+	}
 
-	if err = f.writeFixedFile(origFileLines, issues, tmpOutFile); err != nil {
-		tmpOutFile.Close()
-		_ = robustio.RemoveAll(tmpOutFile.Name())
+	var fixedFile bytes.Buffer
+	if err = f.writeFixedFile(origFileLines, issues, &fixedFile); err != nil {
 		return err
 	}
 
-	tmpOutFile.Close()
-	if err = robustio.Rename(tmpOutFile.Name(), filePath); err != nil {
-		_ = robustio.RemoveAll(tmpOutFile.Name())
-		return errors.Wrapf(err, "failed to rename %s -> %s", tmpOutFile.Name(), filePath)
+	// confirm the fixes: a broken file is worse than unfixed issues.
+	if filepath.Ext(filePath) == ".go" {
+		if _, err = parser.ParseFile(token.NewFileSet(), filePath, fixedFile.Bytes(), parser.AllErrors); err != nil {
+			return errors.Wrap(err, "the fixed file isn't valid Go, the file is left unchanged")
+		}
+	}
+
+	tmpFileName := filepath.Join(filepath.Dir(filePath), fmt.Sprintf(".%s.golangci_fix", filepath.Base(filePath)))
+	if err = os.WriteFile(tmpFileName, fixedFile.Bytes(), filePerm(filePath)); err != nil {
+		_ = robustio.RemoveAll(tmpFileName)
+		return errors.Wrapf(err, "failed to write file %s", tmpFileName)
+	}
+
+	if err = robustio.Rename(tmpFileName, filePath); err != nil {
+		_ = robustio.RemoveAll(tmpFileName)
+		return errors.Wrapf(err, "failed to rename %s -> %s", tmpFileName, filePath)
 	}
 
 	return nil
 }
 
-func (f Fixer) mergeLineIssues(lineNum int, lineIssues []result.Issue, origFileLines [][]byte) *result.Issue {
+// filePerm returns the permissions of the file to keep them, 0644 if they can't be read.
+func filePerm(filePath string) os.FileMode {
+	fi, err := os.Stat(filePath)
+	if err != nil {
+		return 0o644
+	}
+
+	return fi.Mode().Perm()
+}
+
+// conflictError is the error of two different fixes of the same lines or bytes: none of the fixes of the file is applied.
+func conflictError(a, b *result.Issue) error {
+	return fmt.Errorf("conflicting fixes: %s and %s, the file is left unchanged", describeFix(a), describeFix(b))
+}
+
+// describeFix returns e.g. `gofmt at lines 3-5` or `misspell at line 4, columns 8-14`: the columns are 1-based.
+func describeFix(i *result.Issue) string {
+	rng := i.GetLineRange()
+	if rng.From != rng.To {
+		return fmt.Sprintf("%s at lines %d-%d", i.FromLinter, rng.From, rng.To)
+	}
+
+	if inline := i.Replacement.Inline; inline != nil && inline.Length == 1 {
+		return fmt.Sprintf("%s at line %d, column %d", i.FromLinter, rng.From, inline.StartCol+1)
+	}
+
+	if inline := i.Replacement.Inline; inline != nil {
+		return fmt.Sprintf("%s at line %d, columns %d-%d", i.FromLinter, rng.From, inline.StartCol+1, inline.StartCol+inline.Length)
+	}
+
+	return fmt.Sprintf("%s at line %d", i.FromLinter, rng.From)
+}
+
+// isSameFix reports whether the issues have the same fix, e.g. the same formatting fix reported by gofmt and gofumpt.
+func isSameFix(a, b *result.Issue) bool {
+	return a.GetLineRange() == b.GetLineRange() && reflect.DeepEqual(a.Replacement, b.Replacement)
+}
+
+func (f Fixer) mergeLineIssues(lineNum int, lineIssues []result.Issue, origFileLines [][]byte) (*result.Issue, error) {
 	origLine := origFileLines[lineNum-1] // lineNum is 1-based
 
+	lineIssues = f.skipRewrittenFixes(uniqFixes(lineIssues))
+
 	if len(lineIssues) == 1 && lineIssues[0].Replacement.Inline == nil {
-		return &lineIssues[0]
+		return &lineIssues[0], nil
 	}
 
 	// check issues first
 	for ind := range lineIssues {
 		i := &lineIssues[ind]
-		if i.LineRange != nil {
-			f.log.Infof("Line %d has multiple issues but at least one of them is ranged: %#v", lineNum, lineIssues)
-			return &lineIssues[0]
-		}
-
 		r := i.Replacement
-		if r.Inline == nil || len(r.NewLines) != 0 || r.NeedOnlyDelete {
-			f.log.Infof("Line %d has multiple issues but at least one of them isn't inline: %#v", lineNum, lineIssues)
-			return &lineIssues[0]
+		if i.LineRange != nil || r.Inline == nil || len(r.NewLines) != 0 || r.NeedOnlyDelete {
+			// only inline fixes of the same line can be merged: the other fixes replace the whole line.
+			other := &lineIssues[0]
+			if ind == 0 {
+				other = &lineIssues[1]
+			}
+			return nil, conflictError(other, i)
 		}
 
 		if r.Inline.StartCol < 0 || r.Inline.Length <= 0 || r.Inline.StartCol+r.Inline.Length > len(origLine) {
 			f.log.Warnf("Line %d (%q) has invalid inline fix: %#v, %#v", lineNum, origLine, i, r.Inline)
-			return nil
+			return nil, nil
+		}
+	}
+
+	return f.applyInlineFixes(lineIssues, origLine)
+}
+
+// skipRewrittenFixes keeps only the longest fix of several lines, if any, of the fixes starting on the same line:
+// it rewrites the lines of the others, as for the fixes starting on its next lines (see findNotIntersectingIssues).
+func (f Fixer) skipRewrittenFixes(lineIssues []result.Issue) []result.Issue {
+	enclosing := -1
+	for i := range lineIssues {
+		rng := lineIssues[i].GetLineRange()
+		if rng.From != rng.To && (enclosing == -1 || rng.To > lineIssues[enclosing].GetLineRange().To) {
+			enclosing = i
+		}
+	}
+
+	if enclosing == -1 {
+		return lineIssues
+	}
+
+	for i := range lineIssues {
+		if i != enclosing {
+			f.log.Infof("Skip issue %#v: its lines are rewritten by the fix of %#v", &lineIssues[i], &lineIssues[enclosing])
 		}
 	}
 
-	return f.applyInlineFixes(lineIssues, origLine, lineNum)
+	return lineIssues[enclosing : enclosing+1]
 }
 
-func (f Fixer) applyInlineFixes(lineIssues []result.Issue, origLine []byte, lineNum int) *result.Issue {
+// uniqFixes removes the issues with the same fix as a previous one.
+func uniqFixes(issues []result.Issue) []result.Issue {
+	var ret []result.Issue
+	for i := range issues {
+		isDup := false
+		for j := range ret {
+			if isSameFix(&issues[i], &ret[j]) {
+				isDup = true
+				break
+			}
+		}
+
+		if !isDup {
+			ret = append(ret, issues[i])
+		}
+	}
+
+	return ret
+}
+
+func (f Fixer) applyInlineFixes(lineIssues []result.Issue, origLine []byte) (*result.Issue, error) {
 	sort.Slice(lineIssues, func(i, j int) bool {
 		return lineIssues[i].Replacement.Inline.StartCol < lineIssues[j].Replacement.Inline.StartCol
 	})
@@ -163,8 +282,7 @@ func (f Fixer) applyInlineFixes(lineIssues []result.Issue, origLine []byte, line
 	for i := range lineIssues {
 		fix := lineIssues[i].Replacement.Inline
 		if fix.StartCol < curOrigLinePos {
-			f.log.Warnf("Line %d has multiple intersecting issues: %#v", lineNum, lineIssues)
-			return nil
+			return nil, conflictError(&lineIssues[i-1], &lineIssues[i])
 		}
 
 		if curOrigLinePos != fix.StartCol {
@@ -181,13 +299,19 @@ func (f Fixer) applyInlineFixes(lineIssues []result.Issue, origLine []byte, line
 	mergedIssue.Replacement = &result.Replacement{
 		NewLines: []string{newLineBuf.String()},
 	}
-	return &mergedIssue
+	return &mergedIssue, nil
 }
 
-func (f Fixer) findNotIntersectingIssues(issues []result.Issue) []result.Issue {
+// findNotIntersectingIssues returns the issues sorted by line, without the duplicated fixes:
+// it fails if two different fixes intersect.
+func (f Fixer) findNotIntersectingIssues(issues []result.Issue) ([]result.Issue, error) {
+	// the enclosing ranges first.
 	sort.SliceStable(issues, func(i, j int) bool {
-		a, b := issues[i], issues[j]
-		return a.Line() < b.Line()
+		a, b := issues[i].GetLineRange(), issues[j].GetLineRange()
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		return a.To > b.To
 	})
 
 	var ret []result.Issue
@@ -195,19 +319,31 @@ func (f Fixer) findNotIntersectingIssues(issues []result.Issue) []result.Issue {
 	for i := range issues {
 		issue := &issues[i]
 		rng := issue.GetLineRange()
-		if rng.From <= currentEnd {
-			f.log.Infof("Skip issue %#v: intersects with end %d", issue, currentEnd)
-			continue // skip intersecting issue
+		if len(ret) != 0 && rng.From <= currentEnd {
+			last := &ret[len(ret)-1]
+			if isSameFix(last, issue) {
+				f.log.Infof("Skip issue %#v: same fix as %#v", issue, last)
+				continue
+			}
+
+			// e.g. gofmt and goimports: the enclosing fix rewrites these lines too, from the same original lines,
+			// and the linters run again after the fixes report what it doesn't fix.
+			if lastRng := last.GetLineRange(); lastRng.From != lastRng.To && rng.To <= lastRng.To {
+				f.log.Infof("Skip issue %#v: its lines are rewritten by the fix of %#v", issue, last)
+				continue
+			}
+
+			return nil, conflictError(last, issue)
 		}
 		f.log.Infof("Fix issue %#v with range %v", issue, issue.GetLineRange())
 		ret = append(ret, *issue)
 		currentEnd = rng.To
 	}
 
-	return ret
+	return ret, nil
 }
 
-func (f Fixer) writeFixedFile(origFileLines [][]byte, issues []result.Issue, tmpOutFile *os.File) error {
+func (f Fixer) writeFixedFile(origFileLines [][]byte, issues []result.Issue, out io.Writer) error {
 	// issues aren't intersecting
 
 	nextIssueIndex := 0
@@ -240,7 +376,7 @@ func (f Fixer) writeFixedFile(origFileLines [][]byte, issues []result.Issue, tmp
 		if i < len(origFileLines)-1 {
 			outLine += "\n"
 		}
-		if _, err := tmpOutFile.WriteString(outLine); err != nil {
+		if _, err := io.WriteString(out, outLine); err != nil {
 			return errors.Wrap(err, "failed to write output line")
 		}
 	}
@@ -0,0 +1,197 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const fixerSource = `package foo
+
+func foo() {
+	var  x = 1
+	_ = x
+}
+`
+
+func newFixerTestFile(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "foo.go")
+	require.NoError(t, os.WriteFile(path, []byte(fixerSource), 0o600))
+	return path
+}
+
+func newFixerTestIssue(path, linter string, line int, r *result.Replacement) result.Issue {
+	return result.Issue{
+		FromLinter:  linter,
+		Text:        "text",
+		Pos:         token.Position{Filename: path, Line: line},
+		Replacement: r,
+	}
+}
+
+func newTestFixer() *Fixer {
+	cfg := config.NewDefault()
+	cfg.Issues.NeedFix = true
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	log.SetLevel(logutils.LogLevelError)
+
+	return NewFixer(cfg, log, fsutils.NewFileCache())
+}
+
+func readFixerTestFile(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestFixer_SameFixOfSeveralLinters(t *testing.T) {
+	path := newFixerTestFile(t)
+
+	fix := &result.Replacement{NewLines: []string{"\tvar x = 1"}}
+	notFixable := newFixerTestIssue(path, "unused", 3, nil)
+
+	issues := newTestFixer().Process([]result.Issue{
+		newFixerTestIssue(path, "gofmt", 4, fix),
+		newFixerTestIssue(path, "gofumpt", 4, fix),
+		notFixable,
+	})
+
+	assert.Equal(t, []result.Issue{notFixable}, issues)
+	assert.Equal(t, "package foo\n\nfunc foo() {\n\tvar x = 1\n\t_ = x\n}\n", readFixerTestFile(t, path))
+}
+
+func TestFixer_FixInsideRewrittenLines(t *testing.T) {
+	path := newFixerTestFile(t)
+
+	goimports := newFixerTestIssue(path, "goimports", 3, &result.Replacement{NewLines: []string{"func foo() {", "\tvar x = 1"}})
+	goimports.LineRange = &result.Range{From: 3, To: 5}
+
+	issues := newTestFixer().Process([]result.Issue{
+		newFixerTestIssue(path, "gofmt", 4, &result.Replacement{NewLines: []string{"\tvar x = 2"}}),
+		goimports,
+	})
+
+	assert.Empty(t, issues)
+	assert.Equal(t, "package foo\n\nfunc foo() {\n\tvar x = 1\n}\n", readFixerTestFile(t, path))
+}
+
+func TestFixer_FixInsideRewrittenLinesSameStartLine(t *testing.T) {
+	path := newFixerTestFile(t)
+
+	goimports := newFixerTestIssue(path, "goimports", 3, &result.Replacement{NewLines: []string{"func foo() {", "\tvar x = 1"}})
+	goimports.LineRange = &result.Range{From: 3, To: 5}
+
+	// the fixes nested in the range are skipped whatever their first line is.
+	issues := newTestFixer().Process([]result.Issue{
+		newFixerTestIssue(path, "misspell", 3, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 3, NewString: "bar"}}),
+		goimports,
+	})
+
+	assert.Empty(t, issues)
+	assert.Equal(t, "package foo\n\nfunc foo() {\n\tvar x = 1\n}\n", readFixerTestFile(t, path))
+}
+
+func TestFixer_InlineFixes(t *testing.T) {
+	path := newFixerTestFile(t)
+
+	fixer := newTestFixer()
+	issues := fixer.Process([]result.Issue{
+		newFixerTestIssue(path, "a", 4, &result.Replacement{Inline: &result.InlineFix{StartCol: 4, Length: 1, NewString: ""}}),
+		newFixerTestIssue(path, "b", 4, &result.Replacement{Inline: &result.InlineFix{StartCol: 6, Length: 1, NewString: "y"}}),
+		newFixerTestIssue(path, "b", 5, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 1, NewString: "y"}}),
+	})
+
+	assert.Empty(t, issues)
+	assert.Equal(t, "package foo\n\nfunc foo() {\n\tvar y = 1\n\t_ = y\n}\n", readFixerTestFile(t, path))
+	assert.Equal(t, []string{path}, fixer.FixedFiles())
+}
+
+func TestFixer_Conflicts(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		issues func(path string) []result.Issue
+	}{
+		{
+			desc: "intersecting inline fixes",
+			issues: func(path string) []result.Issue {
+				return []result.Issue{
+					newFixerTestIssue(path, "a", 4, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 3, NewString: "y "}}),
+					newFixerTestIssue(path, "b", 4, &result.Replacement{Inline: &result.InlineFix{StartCol: 6, Length: 1, NewString: "z"}}),
+				}
+			},
+		},
+		{
+			desc: "different fixes of a line",
+			issues: func(path string) []result.Issue {
+				return []result.Issue{
+					newFixerTestIssue(path, "a", 4, &result.Replacement{NewLines: []string{"\tvar x = 1"}}),
+					newFixerTestIssue(path, "b", 4, &result.Replacement{NewLines: []string{"\tx := 1"}}),
+				}
+			},
+		},
+		{
+			desc: "intersecting line ranges",
+			issues: func(path string) []result.Issue {
+				a := newFixerTestIssue(path, "a", 3, &result.Replacement{NewLines: []string{"func bar() {", "\tvar x = 1"}})
+				a.LineRange = &result.Range{From: 3, To: 4}
+				b := newFixerTestIssue(path, "b", 4, &result.Replacement{NewLines: []string{"\tx := 1", "\t_ = x"}})
+				b.LineRange = &result.Range{From: 4, To: 5}
+				return []result.Issue{a, b}
+			},
+		},
+		{
+			desc: "conflict after valid fixes of other lines",
+			issues: func(path string) []result.Issue {
+				c := newFixerTestIssue(path, "c", 4, &result.Replacement{NewLines: []string{"\tx := 1", "\t_ = x"}})
+				c.LineRange = &result.Range{From: 4, To: 5}
+				d := newFixerTestIssue(path, "d", 5, &result.Replacement{NewLines: []string{"\t_ = x", "}"}})
+				d.LineRange = &result.Range{From: 5, To: 6}
+				return []result.Issue{
+					newFixerTestIssue(path, "a", 1, &result.Replacement{NewLines: []string{"package bar"}}),
+					newFixerTestIssue(path, "b", 3, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 3, NewString: "bar"}}),
+					c,
+					d,
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			path := newFixerTestFile(t)
+			issues := tc.issues(path)
+
+			// the file isn't fixed: its issues are reported unchanged.
+			fixer := newTestFixer()
+			assert.ElementsMatch(t, issues, fixer.Process(issues))
+			assert.Empty(t, fixer.FixedFiles())
+			assert.Equal(t, fixerSource, readFixerTestFile(t, path))
+		})
+	}
+}
+
+func TestFixer_InvalidFixedFile(t *testing.T) {
+	path := newFixerTestFile(t)
+
+	issues := []result.Issue{
+		newFixerTestIssue(path, "a", 3, &result.Replacement{NewLines: []string{"func foo( {"}}),
+	}
+
+	assert.Equal(t, issues, newTestFixer().Process(issues))
+	assert.Equal(t, fixerSource, readFixerTestFile(t, path))
+}
@@ -0,0 +1,29 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// FreezeFingerprints fixes the fingerprints of the issues (see result.Issue.FreezeFingerprint):
+// the processors after it rewrite the paths and the texts for the output only,
+// the fingerprints of the stores and of the outputs stay the same whatever the output options.
+type FreezeFingerprints struct{}
+
+var _ Processor = FreezeFingerprints{}
+
+func NewFreezeFingerprints() *FreezeFingerprints {
+	return &FreezeFingerprints{}
+}
+
+func (p FreezeFingerprints) Name() string {
+	return "freeze_fingerprints"
+}
+
+func (p FreezeFingerprints) Process(issues []result.Issue) ([]result.Issue, error) {
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		i.FreezeFingerprint()
+		return i
+	}), nil
+}
+
+func (p FreezeFingerprints) Finish() {}
@@ -0,0 +1,26 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFreezeFingerprints(t *testing.T) {
+	issue := newIssueFromIssueTestCase(issueTestCase{Path: "pkg/a.go", Line: 1, Linter: "govet", Text: "printf: bad format"})
+	fingerprint := issue.Fingerprint()
+
+	issues := process(t, NewFreezeFingerprints(), issue)
+	require.Len(t, issues, 1)
+
+	// e.g. output.path-prefix and issues.linter-message-prefix.
+	issues = process(t, NewPathPrefixer("prefix"), issues...)
+	issues = process(t, NewLinterMessagePrefix(map[string]string{"govet": "[VET]"}), issues...)
+	assert.Equal(t, "prefix/pkg/a.go", issues[0].FilePath())
+	assert.Equal(t, "[VET] printf: bad format", issues[0].Text)
+	assert.Equal(t, fingerprint, issues[0].Fingerprint())
+
+	// not frozen: the fingerprint depends on the path.
+	assert.NotEqual(t, fingerprint, process(t, NewPathPrefixer("prefix"), issue)[0].Fingerprint())
+}
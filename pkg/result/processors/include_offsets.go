@@ -0,0 +1,83 @@
+package processors
+
+import (
+	"unicode/utf8"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// IncludeOffsets sets the byte offset in the file (Pos.Offset) of the issues from their line and column,
+// the way the go/token positions count them: the column is in bytes, the line breaks (`\n` or `\r\n`) are counted.
+// A column beyond the end of the line is the end of the line,
+// a column in the middle of a multibyte UTF-8 rune is the start of the rune.
+type IncludeOffsets struct {
+	enabled   bool
+	lineCache *fsutils.LineCache
+	log       logutils.Log
+}
+
+var _ Processor = IncludeOffsets{}
+
+func NewIncludeOffsets(enabled bool, lineCache *fsutils.LineCache, log logutils.Log) *IncludeOffsets {
+	return &IncludeOffsets{
+		enabled:   enabled,
+		lineCache: lineCache,
+		log:       log,
+	}
+}
+
+func (p IncludeOffsets) Name() string {
+	return "include_offsets"
+}
+
+func (p IncludeOffsets) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		if i.Line() <= 0 {
+			return i
+		}
+
+		offset, err := p.offset(i)
+		if err != nil {
+			p.log.Warnf("Failed to compute the offset of line %d of file %s: %s", i.Line(), i.FilePath(), err)
+			return i
+		}
+
+		newI := *i
+		newI.Pos.Offset = offset
+		return &newI
+	}), nil
+}
+
+func (p IncludeOffsets) offset(i *result.Issue) (int, error) {
+	lineOffset, err := p.lineCache.GetLineOffset(i.FilePath(), i.Line())
+	if err != nil {
+		return 0, err
+	}
+
+	if i.Column() <= 1 {
+		return lineOffset, nil
+	}
+
+	line, err := p.lineCache.GetLine(i.FilePath(), i.Line())
+	if err != nil {
+		return 0, err
+	}
+
+	col := i.Column() - 1
+	if col > len(line) {
+		col = len(line)
+	}
+	for col > 0 && col < len(line) && !utf8.RuneStart(line[col]) {
+		col--
+	}
+
+	return lineOffset + col, nil
+}
+
+func (p IncludeOffsets) Finish() {}
@@ -0,0 +1,54 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestIncludeOffsets(t *testing.T) {
+	dir := t.TempDir()
+
+	lf := filepath.Join(dir, "lf.go")
+	require.NoError(t, os.WriteFile(lf, []byte("package p\n\nvar s = \"héllo\" // x\n"), 0o600))
+
+	crlf := filepath.Join(dir, "crlf.go")
+	require.NoError(t, os.WriteFile(crlf, []byte("package p\r\n\r\nvar s = \"héllo\" // x\r\n"), 0o600))
+
+	newIssue := func(file string, line, column int) result.Issue {
+		return result.Issue{Pos: token.Position{Filename: file, Line: line, Column: column}}
+	}
+
+	p := NewIncludeOffsets(true, fsutils.NewLineCache(fsutils.NewFileCache()), logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processedIssues := process(t, p,
+		newIssue(lf, 1, 1),
+		newIssue(lf, 3, 0),  // unknown column: the start of the line
+		newIssue(lf, 3, 18), // after the 2 bytes of é
+		newIssue(lf, 3, 12), // in the middle of é: the start of the rune
+		newIssue(lf, 3, 99), // beyond the line: its end
+		newIssue(crlf, 3, 18),
+		newIssue(crlf, 3, 99), // before the \r
+	)
+
+	var offsets []int
+	for _, i := range processedIssues {
+		offsets = append(offsets, i.Pos.Offset)
+	}
+
+	assert.Equal(t, []int{0, 11, 28, 21, 32, 30, 34}, offsets)
+}
+
+func TestIncludeOffsetsDisabled(t *testing.T) {
+	p := NewIncludeOffsets(false, fsutils.NewLineCache(fsutils.NewFileCache()), logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p, result.Issue{Pos: token.Position{Filename: "missing.go", Line: 1, Column: 1, Offset: 7}})
+}
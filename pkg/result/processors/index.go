@@ -0,0 +1,32 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// Index sets Issue.Index to the 1-based position of each issue.
+// It runs after SortResults: with sort-results the indices are reproducible between runs.
+type Index struct{}
+
+var _ Processor = Index{}
+
+func NewIndex() *Index {
+	return &Index{}
+}
+
+func (p Index) Name() string {
+	return "index"
+}
+
+func (p Index) Process(issues []result.Issue) ([]result.Issue, error) {
+	n := 0
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		n++
+
+		newI := *i
+		newI.Index = n
+		return &newI
+	}), nil
+}
+
+func (p Index) Finish() {}
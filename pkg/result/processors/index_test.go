@@ -0,0 +1,33 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestIndex(t *testing.T) {
+	p := NewIndex()
+
+	issues := []issueTestCase{
+		{Path: "b.go", Line: 1, Linter: "govet"},
+		{Path: "a.go", Line: 2, Linter: "errcheck"},
+		{Path: "a.go", Line: 1, Linter: "errcheck"},
+	}
+
+	var in []result.Issue
+	for _, i := range issues {
+		in = append(in, newIssueFromIssueTestCase(i))
+	}
+
+	out := process(t, p, in...)
+	if assert.Len(t, out, len(in)) {
+		for i := range out {
+			assert.Equal(t, i+1, out[i].Index)
+			assert.Equal(t, in[i].FilePath(), out[i].FilePath())
+			assert.Zero(t, in[i].Index, "the input issues must not be modified")
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package processors
+
+import (
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// InlineGenerated drops the issues whose line contains the marker comment, e.g. `// generated-dont-lint`:
+// it's the line-level counterpart of the generated files detection, for the files mixing generated and hand-written code.
+type InlineGenerated struct {
+	marker    string
+	lineCache *fsutils.LineCache
+	log       logutils.Log
+}
+
+var _ Processor = InlineGenerated{}
+
+func NewInlineGenerated(marker string, lineCache *fsutils.LineCache, log logutils.Log) *InlineGenerated {
+	return &InlineGenerated{
+		marker:    marker,
+		lineCache: lineCache,
+		log:       log,
+	}
+}
+
+func (p InlineGenerated) Name() string {
+	return "inline_generated"
+}
+
+func (p InlineGenerated) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.marker == "" { // disabled
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		line, err := p.lineCache.GetLine(i.FilePath(), i.Line())
+		if err != nil {
+			p.log.Warnf("Failed to get line %s:%d: %s", i.FilePath(), i.Line(), err)
+			return true
+		}
+
+		return !strings.Contains(line, p.marker)
+	}), nil
+}
+
+func (p InlineGenerated) Finish() {}
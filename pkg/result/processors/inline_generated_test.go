@@ -0,0 +1,37 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+const inlineGeneratedSource = `package foo
+
+var tables = map[string]int{"a": 1} // generated-dont-lint
+
+func foo() {}
+`
+
+func TestInlineGenerated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "foo.go")
+	require.NoError(t, os.WriteFile(path, []byte(inlineGeneratedSource), 0o600))
+
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+
+	marked := newIssueFromIssueTestCase(issueTestCase{Path: path, Line: 3, Linter: "gochecknoglobals"})
+	unmarked := newIssueFromIssueTestCase(issueTestCase{Path: path, Line: 5, Linter: "unused"})
+	unknown := newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join(t.TempDir(), "missing.go"), Line: 1})
+
+	p := NewInlineGenerated("// generated-dont-lint", lineCache, log)
+	processAssertEmpty(t, p, marked)
+	processAssertSame(t, p, unmarked, unknown)
+
+	processAssertSame(t, NewInlineGenerated("", lineCache, log), marked, unmarked)
+}
@@ -0,0 +1,38 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// IssueFilter keeps only the issues for which the predicate returns true:
+// it's the filter of the programs embedding golangci-lint (see lint.Runner.SetIssueFilter).
+type IssueFilter struct {
+	keep func(result.Issue) bool // nil: no filtering
+}
+
+var _ Processor = IssueFilter{}
+
+func NewIssueFilter(keep func(result.Issue) bool) *IssueFilter {
+	return &IssueFilter{keep: keep}
+}
+
+// SetKeep sets the predicate, nil for no filtering.
+func (p *IssueFilter) SetKeep(keep func(result.Issue) bool) {
+	p.keep = keep
+}
+
+func (p IssueFilter) Name() string {
+	return "issue_filter"
+}
+
+func (p IssueFilter) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.keep == nil {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		return p.keep(*i)
+	}), nil
+}
+
+func (p IssueFilter) Finish() {}
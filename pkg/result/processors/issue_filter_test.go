@@ -0,0 +1,34 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestIssueFilter(t *testing.T) {
+	p := NewIssueFilter(func(i result.Issue) bool {
+		return i.FromLinter != "gosec"
+	})
+
+	gosec := newIssueFromIssueTestCase(issueTestCase{Linter: "gosec", Text: "G104: Errors unhandled."})
+	govet := newIssueFromIssueTestCase(issueTestCase{Linter: "govet", Text: "printf: bad format"})
+
+	processAssertEmpty(t, p, gosec)
+	processAssertSame(t, p, govet)
+}
+
+func TestIssueFilterNil(t *testing.T) {
+	p := NewIssueFilter(nil)
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Linter: "gosec", Text: "G104: Errors unhandled."}))
+}
+
+func TestIssueFilterSetKeep(t *testing.T) {
+	p := NewIssueFilter(nil)
+	p.SetKeep(func(i result.Issue) bool {
+		return i.FromLinter != "gosec"
+	})
+
+	processAssertEmpty(t, p, newIssueFromIssueTestCase(issueTestCase{Linter: "gosec", Text: "G104: Errors unhandled."}))
+}
@@ -0,0 +1,43 @@
+package processors
+
+import (
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// LinterMessagePrefix prepends the prefix of their linter, followed by a space, to the messages of the issues:
+// e.g. `[SECURITY] G104: Errors unhandled.` for gosec. The messages already starting with the prefix are untouched,
+// the prefix isn't added twice when the issues are processed again.
+type LinterMessagePrefix struct {
+	prefixes map[string]string // linter -> prefix
+}
+
+var _ Processor = LinterMessagePrefix{}
+
+func NewLinterMessagePrefix(prefixes map[string]string) *LinterMessagePrefix {
+	return &LinterMessagePrefix{prefixes: prefixes}
+}
+
+func (p LinterMessagePrefix) Name() string {
+	return "linter_message_prefix"
+}
+
+func (p LinterMessagePrefix) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.prefixes) == 0 {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		prefix := p.prefixes[i.FromLinter]
+		if prefix == "" || strings.HasPrefix(i.Text, prefix+" ") {
+			return i
+		}
+
+		newI := *i
+		newI.Text = prefix + " " + i.Text
+		return &newI
+	}), nil
+}
+
+func (p LinterMessagePrefix) Finish() {}
@@ -0,0 +1,29 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinterMessagePrefix(t *testing.T) {
+	p := NewLinterMessagePrefix(map[string]string{"gosec": "[SECURITY]"})
+
+	gosec := newIssueFromIssueTestCase(issueTestCase{Linter: "gosec", Text: "G104: Errors unhandled."})
+	govet := newIssueFromIssueTestCase(issueTestCase{Linter: "govet", Text: "printf: bad format"})
+
+	processedIssues := process(t, p, gosec, govet)
+	require.Len(t, processedIssues, 2)
+	assert.Equal(t, "[SECURITY] G104: Errors unhandled.", processedIssues[0].Text)
+	assert.Equal(t, govet, processedIssues[1])
+
+	// processed again: the prefix isn't added twice.
+	processAssertSame(t, p, processedIssues...)
+}
+
+func TestLinterMessagePrefixDisabled(t *testing.T) {
+	p := NewLinterMessagePrefix(nil)
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Linter: "gosec", Text: "G104: Errors unhandled."}))
+}
@@ -0,0 +1,77 @@
+package processors
+
+import (
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// MaxDistinctLinters keeps only the issues of the N linters with the most issues.
+// Ties are broken by linter name.
+type MaxDistinctLinters struct {
+	limit int
+	log   logutils.Log
+
+	hiddenLinters linterToCountMap
+}
+
+var _ Processor = &MaxDistinctLinters{}
+
+func NewMaxDistinctLinters(limit int, log logutils.Log) *MaxDistinctLinters {
+	return &MaxDistinctLinters{
+		limit:         limit,
+		log:           log,
+		hiddenLinters: linterToCountMap{},
+	}
+}
+
+func (p MaxDistinctLinters) Name() string {
+	return "max_distinct_linters"
+}
+
+func (p *MaxDistinctLinters) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.limit <= 0 { // no limit
+		return issues, nil
+	}
+
+	counts := linterToCountMap{}
+	for i := range issues {
+		counts[issues[i].FromLinter]++
+	}
+
+	if len(counts) <= p.limit {
+		return issues, nil
+	}
+
+	linters := make([]string, 0, len(counts))
+	for linter := range counts {
+		linters = append(linters, linter)
+	}
+	sort.Slice(linters, func(i, j int) bool {
+		if counts[linters[i]] != counts[linters[j]] {
+			return counts[linters[i]] > counts[linters[j]]
+		}
+		return linters[i] < linters[j]
+	})
+
+	for _, linter := range linters[p.limit:] {
+		p.hiddenLinters[linter] += counts[linter]
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		_, hidden := p.hiddenLinters[i.FromLinter]
+		return !hidden
+	}), nil
+}
+
+func (p MaxDistinctLinters) Finish() {
+	if hidden := countHiddenIssues(p.hiddenLinters, 0); hidden > 0 {
+		p.log.Warnf("Hid %d issues from %d linters due to max-distinct-linters (%d): set it to 0 to show all issues",
+			hidden, len(p.hiddenLinters), p.limit)
+	}
+
+	walkStringToIntMapSortedByValue(p.hiddenLinters, func(linter string, count int) {
+		p.log.Infof("%d issues from linter %s were hidden, use --max-distinct-linters", count, linter)
+	})
+}
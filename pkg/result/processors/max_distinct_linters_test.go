@@ -0,0 +1,66 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestMaxDistinctLinters(t *testing.T) {
+	p := NewMaxDistinctLinters(2, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	issues := []result.Issue{
+		newFromLinterIssue("gofmt"),
+		newFromLinterIssue("govet"),
+		newFromLinterIssue("errcheck"),
+		newFromLinterIssue("govet"),
+		newFromLinterIssue("gosimple"),
+		newFromLinterIssue("errcheck"),
+	}
+
+	processed, err := p.Process(issues)
+	require.NoError(t, err)
+
+	// errcheck and govet have the most issues, gofmt and gosimple are tied and dropped
+	expected := []result.Issue{
+		newFromLinterIssue("govet"),
+		newFromLinterIssue("errcheck"),
+		newFromLinterIssue("govet"),
+		newFromLinterIssue("errcheck"),
+	}
+	assert.Equal(t, expected, processed)
+}
+
+func TestMaxDistinctLintersTies(t *testing.T) {
+	p := NewMaxDistinctLinters(1, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	// same count: ties are broken by linter name
+	processed := process(t, p, newFromLinterIssue("gofmt"), newFromLinterIssue("errcheck"))
+	assert.Equal(t, []result.Issue{newFromLinterIssue("errcheck")}, processed)
+}
+
+func TestMaxDistinctLintersDisabled(t *testing.T) {
+	p := NewMaxDistinctLinters(0, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p, newFromLinterIssue("gofmt"), newFromLinterIssue("errcheck"))
+}
+
+func TestMaxDistinctLintersHiddenSummary(t *testing.T) {
+	log := logutils.NewMockLog()
+	log.On("Infof", mock.Anything, mock.Anything, mock.Anything).Maybe()
+	log.On("Warnf", "Hid %d issues from %d linters due to max-distinct-linters (%d): set it to 0 to show all issues",
+		3, 2, 1).Once()
+
+	p := NewMaxDistinctLinters(1, log)
+	processed := process(t, p, newFromLinterIssue("gofmt"), newFromLinterIssue("govet"),
+		newFromLinterIssue("govet"), newFromLinterIssue("errcheck"), newFromLinterIssue("errcheck"))
+	assert.Len(t, processed, 2)
+
+	p.Finish()
+	log.AssertExpectations(t)
+}
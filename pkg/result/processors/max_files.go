@@ -0,0 +1,78 @@
+package processors
+
+import (
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// MaxFiles keeps only the issues of the N files with the most issues.
+// Ties are broken by file path.
+type MaxFiles struct {
+	limit int
+	log   logutils.Log
+
+	hiddenFiles map[string]int // file path -> count of hidden issues
+}
+
+var _ Processor = &MaxFiles{}
+
+func NewMaxFiles(limit int, log logutils.Log) *MaxFiles {
+	return &MaxFiles{
+		limit:       limit,
+		log:         log,
+		hiddenFiles: map[string]int{},
+	}
+}
+
+func (p MaxFiles) Name() string {
+	return "max_files"
+}
+
+func (p *MaxFiles) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.limit <= 0 { // no limit
+		return issues, nil
+	}
+
+	counts := map[string]int{}
+	for i := range issues {
+		counts[issues[i].FilePath()]++
+	}
+
+	if len(counts) <= p.limit {
+		return issues, nil
+	}
+
+	files := make([]string, 0, len(counts))
+	for file := range counts {
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if counts[files[i]] != counts[files[j]] {
+			return counts[files[i]] > counts[files[j]]
+		}
+		return files[i] < files[j]
+	})
+
+	for _, file := range files[p.limit:] {
+		p.hiddenFiles[file] += counts[file]
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		_, hidden := p.hiddenFiles[i.FilePath()]
+		return !hidden
+	}), nil
+}
+
+func (p MaxFiles) Finish() {
+	var hidden int
+	for _, count := range p.hiddenFiles {
+		hidden += count
+	}
+
+	if hidden > 0 {
+		p.log.Warnf("Hid %d issues from %d files due to max-files (%d): set it to 0 to show all issues",
+			hidden, len(p.hiddenFiles), p.limit)
+	}
+}
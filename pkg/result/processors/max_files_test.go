@@ -0,0 +1,62 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newFileLineIssue(file string, line int) result.Issue {
+	return newIssueFromIssueTestCase(issueTestCase{Path: file, Line: line, Linter: "govet"})
+}
+
+func TestMaxFiles(t *testing.T) {
+	p := NewMaxFiles(2, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processed := process(t, p,
+		newFileLineIssue("a.go", 1),
+		newFileLineIssue("b.go", 1),
+		newFileLineIssue("c.go", 1),
+		newFileLineIssue("b.go", 2),
+		newFileLineIssue("d.go", 1),
+		newFileLineIssue("c.go", 2))
+
+	// b.go and c.go have the most issues, a.go and d.go are tied and dropped
+	expected := []result.Issue{
+		newFileLineIssue("b.go", 1),
+		newFileLineIssue("c.go", 1),
+		newFileLineIssue("b.go", 2),
+		newFileLineIssue("c.go", 2),
+	}
+	assert.Equal(t, expected, processed)
+}
+
+func TestMaxFilesTies(t *testing.T) {
+	p := NewMaxFiles(1, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	// same count: ties are broken by path
+	processed := process(t, p, newFileLineIssue("b.go", 1), newFileLineIssue("a.go", 1))
+	assert.Equal(t, []result.Issue{newFileLineIssue("a.go", 1)}, processed)
+}
+
+func TestMaxFilesDisabled(t *testing.T) {
+	p := NewMaxFiles(0, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p, newFileLineIssue("a.go", 1), newFileLineIssue("b.go", 1))
+}
+
+func TestMaxFilesHiddenSummary(t *testing.T) {
+	log := logutils.NewMockLog()
+	log.On("Warnf", "Hid %d issues from %d files due to max-files (%d): set it to 0 to show all issues", 3, 2, 1).Once()
+
+	p := NewMaxFiles(1, log)
+	processed := process(t, p, newFileLineIssue("a.go", 1), newFileLineIssue("a.go", 2), newFileLineIssue("a.go", 3),
+		newFileLineIssue("b.go", 1), newFileLineIssue("b.go", 2), newFileLineIssue("c.go", 1))
+	assert.Len(t, processed, 3)
+
+	p.Finish()
+	log.AssertExpectations(t)
+}
@@ -45,6 +45,10 @@ func (p *MaxFromLinter) Process(issues []result.Issue) ([]result.Issue, error) {
 }
 
 func (p MaxFromLinter) Finish() {
+	if hidden := countHiddenIssues(p.lc, p.limit); hidden > 0 {
+		p.log.Warnf("Hid %d issues due to max-issues-per-linter (%d): set it to 0 to show all issues", hidden, p.limit)
+	}
+
 	walkStringToIntMapSortedByValue(p.lc, func(linter string, count int) {
 		if count > p.limit {
 			p.log.Infof("%d/%d issues from linter %s were hidden, use --max-issues-per-linter",
@@ -3,6 +3,8 @@ package processors
 import (
 	"testing"
 
+	"github.com/stretchr/testify/mock"
+
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 )
@@ -15,3 +17,18 @@ func TestMaxFromLinter(t *testing.T) {
 	processAssertSame(t, p, gofmt)     // ok: another
 	processAssertEmpty(t, p, gosimple) // skip
 }
+
+func TestMaxFromLinterHiddenSummary(t *testing.T) {
+	log := logutils.NewMockLog()
+	log.On("Infof", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	log.On("Warnf", "Hid %d issues due to max-issues-per-linter (%d): set it to 0 to show all issues", 3, 1).Once()
+
+	p := NewMaxFromLinter(1, log, &config.Config{})
+	processAssertSame(t, p, newFromLinterIssue("gosimple"))
+	processAssertEmpty(t, p, newFromLinterIssue("gosimple"), newFromLinterIssue("gosimple"))
+	processAssertSame(t, p, newFromLinterIssue("gofmt"))
+	processAssertEmpty(t, p, newFromLinterIssue("gofmt"))
+
+	p.Finish()
+	log.AssertExpectations(t)
+}
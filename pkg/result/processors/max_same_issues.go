@@ -49,6 +49,10 @@ func (p *MaxSameIssues) Process(issues []result.Issue) ([]result.Issue, error) {
 }
 
 func (p MaxSameIssues) Finish() {
+	if hidden := countHiddenIssues(p.tc, p.limit); hidden > 0 {
+		p.log.Warnf("Hid %d issues due to max-same-issues (%d): set it to 0 to show all issues", hidden, p.limit)
+	}
+
 	walkStringToIntMapSortedByValue(p.tc, func(text string, count int) {
 		if count > p.limit {
 			p.log.Infof("%d/%d issues with text %q were hidden, use --max-same-issues",
@@ -57,6 +61,17 @@ func (p MaxSameIssues) Finish() {
 	})
 }
 
+// countHiddenIssues returns the number of issues above the limit.
+func countHiddenIssues(m map[string]int, limit int) int {
+	var hidden int
+	for _, count := range m {
+		if count > limit {
+			hidden += count - limit
+		}
+	}
+	return hidden
+}
+
 type kv struct {
 	Key   string
 	Value int
@@ -3,6 +3,8 @@ package processors
 import (
 	"testing"
 
+	"github.com/stretchr/testify/mock"
+
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
@@ -21,3 +23,27 @@ func TestMaxSameIssues(t *testing.T) {
 	processAssertSame(t, p, i2)  // ok: another
 	processAssertEmpty(t, p, i1) // skip
 }
+
+func TestMaxSameIssuesHiddenSummary(t *testing.T) {
+	log := logutils.NewMockLog()
+	log.On("Infof", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	log.On("Warnf", "Hid %d issues due to max-same-issues (%d): set it to 0 to show all issues", 2, 1).Once()
+
+	p := NewMaxSameIssues(1, log, &config.Config{})
+	processAssertSame(t, p, result.Issue{Text: "1"})
+	processAssertEmpty(t, p, result.Issue{Text: "1"}, result.Issue{Text: "1"})
+
+	p.Finish()
+	log.AssertExpectations(t)
+}
+
+func TestMaxSameIssuesNoHiddenSummary(t *testing.T) {
+	log := logutils.NewMockLog()
+
+	p := NewMaxSameIssues(1, log, &config.Config{})
+	processAssertSame(t, p, result.Issue{Text: "1"}, result.Issue{Text: "2"})
+
+	// no log calls are expected
+	p.Finish()
+	log.AssertExpectations(t)
+}
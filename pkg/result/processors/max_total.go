@@ -0,0 +1,54 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// MaxTotal truncates the issues to the first ones, an absolute ceiling on the count of reported issues.
+// It must run after the issues are sorted: the truncation is deterministic.
+type MaxTotal struct {
+	limit int
+	log   logutils.Log
+
+	total int
+}
+
+var _ Processor = &MaxTotal{}
+
+func NewMaxTotal(limit int, log logutils.Log) *MaxTotal {
+	return &MaxTotal{
+		limit: limit,
+		log:   log,
+	}
+}
+
+func (p MaxTotal) Name() string {
+	return "max_total"
+}
+
+func (p *MaxTotal) Process(issues []result.Issue) ([]result.Issue, error) {
+	p.total += len(issues)
+
+	if p.limit <= 0 || len(issues) <= p.limit { // disabled or not reached
+		return issues, nil
+	}
+
+	return issues[:p.limit], nil
+}
+
+func (p MaxTotal) Finish() {
+	if p.Reached() {
+		p.log.Warnf("Reported only the first %d issues of %d: the issues.max-total limit was reached", p.limit, p.total)
+	}
+}
+
+// Reached returns true if issues were dropped because of the limit.
+func (p MaxTotal) Reached() bool {
+	return p.limit > 0 && p.total > p.limit
+}
+
+// Total returns the count of the issues before the truncation.
+func (p MaxTotal) Total() int {
+	return p.total
+}
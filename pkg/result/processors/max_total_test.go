@@ -0,0 +1,40 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestMaxTotal(t *testing.T) {
+	p := NewMaxTotal(2, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	first := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "govet"})
+	second := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Linter: "govet"})
+	third := newIssueFromIssueTestCase(issueTestCase{Path: "b.go", Line: 1, Linter: "errcheck"})
+
+	assert.Equal(t, []result.Issue{first, second}, process(t, p, first, second, third))
+	assert.True(t, p.Reached())
+	assert.Equal(t, 3, p.Total())
+}
+
+func TestMaxTotalNotReached(t *testing.T) {
+	p := NewMaxTotal(2, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p,
+		newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "govet"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Linter: "govet"}))
+	assert.False(t, p.Reached())
+}
+
+func TestMaxTotalDisabled(t *testing.T) {
+	p := NewMaxTotal(0, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p,
+		newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "govet"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Linter: "govet"}))
+	assert.False(t, p.Reached())
+}
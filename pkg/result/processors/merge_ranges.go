@@ -0,0 +1,93 @@
+package processors
+
+import (
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// MergeRanges merges the pairs of issues bracketing a range into one ranged issue,
+// for the linters reporting the start and the end of a range as two issues.
+// The issues of such a linter with the same text in the same file, without end position,
+// are paired in the order of their positions: the first with the second, the third with the fourth, etc.
+// The merged issue is the start one, with the end position and the line range of the end one.
+type MergeRanges struct {
+	linters map[string]bool
+}
+
+var _ Processor = &MergeRanges{}
+
+func NewMergeRanges(linters []string) *MergeRanges {
+	p := &MergeRanges{linters: map[string]bool{}}
+	for _, linter := range linters {
+		p.linters[linter] = true
+	}
+
+	return p
+}
+
+func (p MergeRanges) Name() string {
+	return "merge_ranges"
+}
+
+func (p *MergeRanges) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.linters) == 0 {
+		return issues, nil
+	}
+
+	type pairKey struct {
+		linter, file, text string
+	}
+
+	candidates := map[pairKey][]*result.Issue{}
+	for ind := range issues {
+		i := &issues[ind]
+		if !p.linters[i.FromLinter] || i.EndPos != nil {
+			continue
+		}
+
+		key := pairKey{linter: i.FromLinter, file: i.FilePath(), text: i.Text}
+		candidates[key] = append(candidates[key], i)
+	}
+
+	ends := map[*result.Issue]*result.Issue{} // start -> end
+	merged := map[*result.Issue]bool{}        // the end issues merged into their start
+	for _, pairIssues := range candidates {
+		sort.SliceStable(pairIssues, func(i, j int) bool {
+			return isBeforeInFile(pairIssues[i], pairIssues[j])
+		})
+
+		for ind := 0; ind+1 < len(pairIssues); ind += 2 {
+			start, end := pairIssues[ind], pairIssues[ind+1]
+			ends[start] = end
+			merged[end] = true
+		}
+	}
+
+	if len(merged) == 0 {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		if merged[i] {
+			return nil
+		}
+
+		end := ends[i]
+		if end == nil {
+			return i
+		}
+
+		newI := *i
+		newI.EndPos = &result.EndPosition{Line: end.Line(), Column: end.Column()}
+
+		// the line range of an issue with a replacement is the range of lines to replace.
+		if newI.Replacement == nil {
+			newI.LineRange = &result.Range{From: i.Line(), To: end.GetLineRange().To}
+		}
+
+		return &newI
+	}), nil
+}
+
+func (p MergeRanges) Finish() {}
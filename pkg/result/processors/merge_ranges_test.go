@@ -0,0 +1,63 @@
+package processors
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newRangeIssue(linter, file, text string, line, column int) result.Issue {
+	return result.Issue{
+		FromLinter: linter,
+		Text:       text,
+		Pos: token.Position{
+			Filename: file,
+			Line:     line,
+			Column:   column,
+		},
+	}
+}
+
+func TestMergeRanges(t *testing.T) {
+	p := NewMergeRanges([]string{"ranger"})
+
+	start1 := newRangeIssue("ranger", "a.go", "unsafe block", 3, 2)
+	end1 := newRangeIssue("ranger", "a.go", "unsafe block", 8, 5)
+	start2 := newRangeIssue("ranger", "a.go", "unsafe block", 20, 1)
+	end2 := newRangeIssue("ranger", "a.go", "unsafe block", 22, 1)
+	unpaired := newRangeIssue("ranger", "a.go", "other block", 10, 1)
+	other := newRangeIssue("govet", "a.go", "unsafe block", 4, 1)
+
+	processed := process(t, p, end2, start1, other, start2, unpaired, end1)
+
+	merged1 := start1
+	merged1.EndPos = &result.EndPosition{Line: 8, Column: 5}
+	merged1.LineRange = &result.Range{From: 3, To: 8}
+
+	merged2 := start2
+	merged2.EndPos = &result.EndPosition{Line: 22, Column: 1}
+	merged2.LineRange = &result.Range{From: 20, To: 22}
+
+	assert.Equal(t, []result.Issue{merged1, other, merged2, unpaired}, processed)
+}
+
+func TestMergeRangesWithEndPos(t *testing.T) {
+	p := NewMergeRanges([]string{"ranger"})
+
+	// the issues already having a range aren't merged.
+	ranged := newRangeIssue("ranger", "a.go", "unsafe block", 3, 2)
+	ranged.EndPos = &result.EndPosition{Line: 5, Column: 1}
+
+	processAssertSame(t, p, ranged, newRangeIssue("ranger", "a.go", "unsafe block", 8, 5))
+}
+
+func TestMergeRangesDisabled(t *testing.T) {
+	p := NewMergeRanges(nil)
+
+	processAssertSame(t, p,
+		newRangeIssue("ranger", "a.go", "unsafe block", 3, 2),
+		newRangeIssue("ranger", "a.go", "unsafe block", 8, 5))
+}
@@ -0,0 +1,68 @@
+package processors
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type messageTranslation struct {
+	re       *regexp.Regexp
+	template string
+}
+
+// MessageTranslations replaces the messages of the issues matching the pattern of a translation
+// by its template, where the capture groups of the pattern are expanded (`$1`, `${name}`, see regexp.Expand):
+// e.g. to preserve the identifiers. The first matching translation wins, the unmatched messages are untouched.
+type MessageTranslations struct {
+	translations []messageTranslation
+}
+
+var _ Processor = MessageTranslations{}
+
+func NewMessageTranslations(translations []config.MessageTranslation) (*MessageTranslations, error) {
+	p := &MessageTranslations{}
+	for ind, t := range translations {
+		if t.Pattern == "" {
+			return nil, fmt.Errorf("message translation #%d: empty pattern", ind)
+		}
+
+		re, err := regexp.Compile(t.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("message translation #%d: invalid pattern %q: %w", ind, t.Pattern, err)
+		}
+
+		p.translations = append(p.translations, messageTranslation{re: re, template: t.Template})
+	}
+
+	return p, nil
+}
+
+func (p MessageTranslations) Name() string {
+	return "message_translations"
+}
+
+func (p MessageTranslations) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.translations) == 0 {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		for _, t := range p.translations {
+			match := t.re.FindStringSubmatchIndex(i.Text)
+			if match == nil {
+				continue
+			}
+
+			newI := *i
+			newI.Text = string(t.re.ExpandString(nil, t.template, i.Text, match))
+			return &newI
+		}
+
+		return i
+	}), nil
+}
+
+func (p MessageTranslations) Finish() {}
@@ -0,0 +1,53 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+func TestMessageTranslations(t *testing.T) {
+	p, err := NewMessageTranslations([]config.MessageTranslation{
+		{Pattern: `^Error return value of (?P<call>\S+) is not checked$`, Template: "La valeur d'erreur de ${call} n'est pas vérifiée"},
+		{Pattern: `^(\S+) is unused$`, Template: "$1 n'est pas utilisé"},
+		{Pattern: `is unused`, Template: "never used"}, // the first matching translation wins
+	})
+	require.NoError(t, err)
+
+	processedIssues := process(t, p,
+		newIssueFromTextTestCase("Error return value of `f.Close` is not checked"),
+		newIssueFromTextTestCase("func `foo` is unused"),
+		newIssueFromTextTestCase("fooBar is unused"),
+		newIssueFromTextTestCase("line is 130 characters"))
+
+	var texts []string
+	for _, i := range processedIssues {
+		texts = append(texts, i.Text)
+	}
+
+	expected := []string{
+		"La valeur d'erreur de `f.Close` n'est pas vérifiée",
+		"never used",
+		"fooBar n'est pas utilisé",
+		"line is 130 characters",
+	}
+	assert.Equal(t, expected, texts)
+}
+
+func TestMessageTranslationsInvalid(t *testing.T) {
+	_, err := NewMessageTranslations([]config.MessageTranslation{{Pattern: `(`, Template: "x"}})
+	assert.Error(t, err)
+
+	_, err = NewMessageTranslations([]config.MessageTranslation{{Template: "x"}})
+	assert.Error(t, err)
+}
+
+func TestMessageTranslationsEmpty(t *testing.T) {
+	p, err := NewMessageTranslations(nil)
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newIssueFromTextTestCase("func `foo` is unused"))
+}
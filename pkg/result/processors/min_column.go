@@ -0,0 +1,48 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// MinColumn drops the issues reported before a minimum column,
+// e.g. the issues of a formatter on the auto-generated prefixes of the lines.
+// The minimum column of a linter overrides the global one, 0 disables it for the linter.
+// The issues with an unknown column (0) are kept.
+type MinColumn struct {
+	minColumn int
+	perLinter map[string]int
+}
+
+var _ Processor = MinColumn{}
+
+func NewMinColumn(minColumn int, perLinter map[string]int) *MinColumn {
+	return &MinColumn{
+		minColumn: minColumn,
+		perLinter: perLinter,
+	}
+}
+
+func (p MinColumn) Name() string {
+	return "min_column"
+}
+
+func (p MinColumn) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.minColumn <= 0 && len(p.perLinter) == 0 { // disabled
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		minColumn, ok := p.perLinter[i.FromLinter]
+		if !ok {
+			minColumn = p.minColumn
+		}
+
+		if minColumn <= 0 || i.Column() == 0 {
+			return true
+		}
+
+		return i.Column() >= minColumn
+	}), nil
+}
+
+func (p MinColumn) Finish() {}
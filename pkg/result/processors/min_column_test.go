@@ -0,0 +1,25 @@
+package processors
+
+import (
+	"testing"
+)
+
+func TestMinColumn(t *testing.T) {
+	p := NewMinColumn(5, map[string]int{"gofmt": 0, "lll": 10})
+
+	before := newRangeIssue("govet", "a.go", "issue", 1, 1)
+	at := newRangeIssue("govet", "a.go", "issue", 2, 5)
+	unknown := newRangeIssue("govet", "a.go", "issue", 3, 0)
+	disabledForLinter := newRangeIssue("gofmt", "a.go", "issue", 4, 1)
+	beforeForLinter := newRangeIssue("lll", "a.go", "issue", 5, 7)
+	afterForLinter := newRangeIssue("lll", "a.go", "issue", 6, 12)
+
+	processAssertEmpty(t, p, before, beforeForLinter)
+	processAssertSame(t, p, at, unknown, disabledForLinter, afterForLinter)
+}
+
+func TestMinColumnDisabled(t *testing.T) {
+	p := NewMinColumn(0, nil)
+
+	processAssertSame(t, p, newRangeIssue("govet", "a.go", "issue", 1, 1))
+}
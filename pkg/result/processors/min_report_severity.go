@@ -0,0 +1,49 @@
+package processors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// MinReportSeverity drops the issues with a severity below the floor, in the order of severityLevels:
+// info < warning < error. The other severities, and the empty one, rank below info:
+// with a floor, the issues without a severity are dropped, unless severity.default-severity assigns one.
+// It must run after the severities are assigned.
+type MinReportSeverity struct {
+	floorRank int
+}
+
+var _ Processor = MinReportSeverity{}
+
+// NewMinReportSeverity returns a processor dropping the issues below the severity (case-insensitive).
+// An empty severity means no filtering.
+func NewMinReportSeverity(severity string) (*MinReportSeverity, error) {
+	if severity == "" {
+		return &MinReportSeverity{floorRank: -1}, nil
+	}
+
+	rank := severityRank(strings.ToLower(severity))
+	if rank < 0 {
+		return nil, fmt.Errorf("unknown severity %q: must be one of %s", severity, strings.Join(severityLevels, ", "))
+	}
+
+	return &MinReportSeverity{floorRank: rank}, nil
+}
+
+func (p MinReportSeverity) Name() string {
+	return "min_report_severity"
+}
+
+func (p MinReportSeverity) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.floorRank < 0 { // disabled
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		return severityRank(strings.ToLower(i.Severity)) >= p.floorRank
+	}), nil
+}
+
+func (p MinReportSeverity) Finish() {}
@@ -0,0 +1,40 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newSeverityTestIssue(severity string) result.Issue {
+	return result.Issue{FromLinter: "linter", Text: "text", Severity: severity}
+}
+
+func TestMinReportSeverity(t *testing.T) {
+	p, err := NewMinReportSeverity("Warning")
+	require.NoError(t, err)
+
+	info := newSeverityTestIssue("info")
+	unknown := newSeverityTestIssue("low")
+	warning := newSeverityTestIssue("warning")
+	errorIssue := newSeverityTestIssue("ERROR")
+	none := newSeverityTestIssue("")
+
+	processAssertEmpty(t, p, info, unknown, none)
+	processAssertSame(t, p, warning, errorIssue)
+}
+
+func TestMinReportSeverityDisabled(t *testing.T) {
+	p, err := NewMinReportSeverity("")
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newSeverityTestIssue("info"), newSeverityTestIssue("low"), newSeverityTestIssue(""))
+}
+
+func TestMinReportSeverityInvalid(t *testing.T) {
+	_, err := NewMinReportSeverity("critical")
+	assert.EqualError(t, err, `unknown severity "critical": must be one of info, warning, error`)
+}
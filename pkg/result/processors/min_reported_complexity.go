@@ -0,0 +1,78 @@
+package processors
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// complexityLinters are the linters embedding the computed complexity in their messages.
+var complexityLinters = map[string]bool{
+	"cyclop":   true,
+	"gocognit": true,
+	"gocyclo":  true,
+}
+
+var complexityRes = []*regexp.Regexp{
+	// "cyclomatic complexity 31 of func `foo` is high (> 30)"
+	regexp.MustCompile(`(?i)(?:cyclomatic|cognitive)\s+complexity\s*[:=]?\s*(\d+)`),
+	// "calculated cyclomatic complexity for function foo is 11, max is 10"
+	regexp.MustCompile(`(?i)(?:cyclomatic|cognitive)\s+complexity\b.*?\bis\s+(\d+)`),
+}
+
+// MinReportedComplexity drops issues of the complexity linters
+// when the complexity reported in the message is below the threshold.
+type MinReportedComplexity struct {
+	threshold int
+}
+
+var _ Processor = MinReportedComplexity{}
+
+func NewMinReportedComplexity(threshold int) *MinReportedComplexity {
+	return &MinReportedComplexity{threshold: threshold}
+}
+
+func (p MinReportedComplexity) Name() string {
+	return "min_reported_complexity"
+}
+
+func (p MinReportedComplexity) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.threshold <= 0 { // disabled
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		if !complexityLinters[i.FromLinter] {
+			return true
+		}
+
+		complexity, ok := parseReportedComplexity(i.Text)
+		if !ok {
+			// unknown message format: don't drop what we don't understand
+			return true
+		}
+
+		return complexity >= p.threshold
+	}), nil
+}
+
+func (p MinReportedComplexity) Finish() {}
+
+func parseReportedComplexity(text string) (int, bool) {
+	for _, re := range complexityRes {
+		m := re.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+
+		complexity, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+
+		return complexity, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,49 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReportedComplexity(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected int
+		ok       bool
+	}{
+		{text: "cyclomatic complexity 31 of func `foo` is high (> 30)", expected: 31, ok: true},
+		{text: "cognitive complexity 12 of func (*T).foo is high (> 10)", expected: 12, ok: true},
+		{text: "calculated cyclomatic complexity for function foo2 is 11, max is 10", expected: 11, ok: true},
+		{text: "Cyclomatic complexity: 42 is too high", expected: 42, ok: true},
+		{text: "function is too complex", ok: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.text, func(t *testing.T) {
+			complexity, ok := parseReportedComplexity(tc.text)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, complexity)
+		})
+	}
+}
+
+func TestMinReportedComplexity(t *testing.T) {
+	p := NewMinReportedComplexity(20)
+
+	low := newIssueFromIssueTestCase(issueTestCase{Linter: "gocyclo", Text: "cyclomatic complexity 15 of func `foo` is high (> 10)"})
+	high := newIssueFromIssueTestCase(issueTestCase{Linter: "gocognit", Text: "cognitive complexity 25 of func `bar` is high (> 10)"})
+	unknown := newIssueFromIssueTestCase(issueTestCase{Linter: "cyclop", Text: "function is too complex"})
+	other := newIssueFromIssueTestCase(issueTestCase{Linter: "funlen", Text: "Function 'foo' has too many statements (15 > 10)"})
+
+	processAssertEmpty(t, p, low)
+	processAssertSame(t, p, high, unknown, other)
+}
+
+func TestMinReportedComplexityDisabled(t *testing.T) {
+	p := NewMinReportedComplexity(0)
+
+	processAssertSame(t, p,
+		newIssueFromIssueTestCase(issueTestCase{Linter: "gocyclo", Text: "cyclomatic complexity 15 of func `foo` is high (> 10)"}))
+}
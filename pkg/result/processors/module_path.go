@@ -0,0 +1,34 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// ModulePath sets the path of the module (from go.mod) owning the package of each issue.
+// It differs from the import path when the module is replaced or in a workspace.
+// Issues without a package or outside any module get an empty path.
+type ModulePath struct{}
+
+var _ Processor = ModulePath{}
+
+func NewModulePath() *ModulePath {
+	return &ModulePath{}
+}
+
+func (p ModulePath) Name() string {
+	return "module_path"
+}
+
+func (p ModulePath) Process(issues []result.Issue) ([]result.Issue, error) {
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		if i.Pkg == nil || i.Pkg.Module == nil || i.Pkg.Module.Path == "" {
+			return i
+		}
+
+		newI := *i
+		newI.ModulePath = i.Pkg.Module.Path
+		return &newI
+	}), nil
+}
+
+func (p ModulePath) Finish() {}
@@ -0,0 +1,34 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestModulePath(t *testing.T) {
+	p := NewModulePath()
+
+	inModule := result.Issue{
+		Text: "in module",
+		Pkg: &packages.Package{
+			PkgPath: "example.com/remapped/pkg",
+			Module:  &packages.Module{Path: "github.com/org/repo"},
+		},
+	}
+	outsideModule := result.Issue{Text: "outside module", Pkg: &packages.Package{PkgPath: "pkg"}}
+	withoutPkg := result.Issue{Text: "without package"}
+
+	processed := process(t, p, inModule, outsideModule, withoutPkg)
+	require.Len(t, processed, 3)
+	assert.Equal(t, "github.com/org/repo", processed[0].ModulePath)
+	assert.Empty(t, processed[1].ModulePath)
+	assert.Empty(t, processed[2].ModulePath)
+
+	// the input issues aren't modified
+	assert.Empty(t, inModule.ModulePath)
+}
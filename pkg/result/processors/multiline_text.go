@@ -0,0 +1,49 @@
+package processors
+
+import (
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// MultilineText splits the multi-line messages of the issues:
+// the first line is kept as the text and the continuation lines are moved to the details.
+// Single-line messages are untouched.
+type MultilineText struct{}
+
+var _ Processor = MultilineText{}
+
+func NewMultilineText() *MultilineText {
+	return &MultilineText{}
+}
+
+func (p MultilineText) Name() string {
+	return "multiline_text"
+}
+
+func (p MultilineText) Process(issues []result.Issue) ([]result.Issue, error) {
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		text := strings.TrimRight(i.Text, "\r\n")
+		if !strings.Contains(text, "\n") {
+			if text == i.Text {
+				return i
+			}
+
+			newI := *i
+			newI.Text = text
+			return &newI
+		}
+
+		lines := strings.Split(text, "\n")
+		for j := range lines {
+			lines[j] = strings.TrimRight(lines[j], "\r")
+		}
+
+		newI := *i
+		newI.Text = lines[0]
+		newI.Details = append(append([]string{}, i.Details...), lines[1:]...)
+		return &newI
+	}), nil
+}
+
+func (p MultilineText) Finish() {}
@@ -0,0 +1,43 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestMultilineText(t *testing.T) {
+	p := NewMultilineText()
+
+	multiline := result.Issue{Text: "first line\r\n\tsecond line\n\tthird line\n"}
+	trailingNewline := result.Issue{Text: "single line\n"}
+
+	processed := process(t, p, multiline, trailingNewline)
+	require.Len(t, processed, 2)
+
+	assert.Equal(t, "first line", processed[0].Text)
+	assert.Equal(t, []string{"\tsecond line", "\tthird line"}, processed[0].Details)
+
+	assert.Equal(t, "single line", processed[1].Text)
+	assert.Empty(t, processed[1].Details)
+
+	// the input issues aren't modified
+	assert.Empty(t, multiline.Details)
+}
+
+func TestMultilineTextSingleLine(t *testing.T) {
+	processAssertSame(t, NewMultilineText(), result.Issue{Text: "single line"})
+}
+
+func TestMultilineTextFingerprint(t *testing.T) {
+	issue := result.Issue{Text: "first line\nsecond line"}
+
+	processed := process(t, NewMultilineText(), issue)
+	require.Len(t, processed, 1)
+
+	// the fingerprint doesn't change with the split
+	assert.Equal(t, issue.Fingerprint(), processed[0].Fingerprint())
+}
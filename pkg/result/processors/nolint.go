@@ -9,6 +9,9 @@ import (
 	"sort"
 	"strings"
 
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/golinters"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
@@ -19,8 +22,15 @@ import (
 var nolintDebugf = logutils.Debug(logutils.DebugKeyNolint)
 var nolintRe = regexp.MustCompile(`^nolint( |:|$)`)
 
+const (
+	nolintKeyword     = "nolint"
+	lintIgnoreKeyword = "lint:ignore"
+)
+
 type ignoredRange struct {
 	linters                []string
+	exceptLinters          []string // linters still reported by a `nolint:all except:...` directive
+	checks                 []string // check codes (e.g. SA1019) of a `lint:ignore` directive, matched with the issue text prefix
 	matchedIssueFromLinter map[string]bool
 	result.Range
 	col           int
@@ -33,7 +43,8 @@ func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
 	}
 
 	// only allow selective nolinting of nolintlint
-	nolintFoundForLinter := len(i.linters) == 0 && issue.FromLinter != golinters.NoLintLintName
+	nolintFoundForLinter := len(i.linters) == 0 && len(i.checks) == 0 && issue.FromLinter != golinters.NoLintLintName &&
+		!i.isExceptLinter(issue.FromLinter)
 
 	for _, linterName := range i.linters {
 		if linterName == issue.FromLinter {
@@ -42,6 +53,14 @@ func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
 		}
 	}
 
+	// the check ID prefixes the message, or is the CheckID of the issue (e.g. set by the linter).
+	for _, check := range i.checks {
+		if issue.CheckID == check || strings.HasPrefix(issue.Text, check+":") {
+			nolintFoundForLinter = true
+			break
+		}
+	}
+
 	if nolintFoundForLinter {
 		return true
 	}
@@ -58,8 +77,38 @@ func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
 	return false
 }
 
+func (i *ignoredRange) isExceptLinter(linterName string) bool {
+	for _, name := range i.exceptLinters {
+		if name == linterName {
+			return true
+		}
+	}
+
+	return false
+}
+
 type fileData struct {
 	ignoredRanges []ignoredRange
+	directives    []nolintDirective
+}
+
+// nolintDirective is a nolint directive comment of a file.
+type nolintDirective struct {
+	pos       token.Position
+	endOffset int
+	text      string
+}
+
+// UnusedNolintDirective is a nolint directive which doesn't suppress any issue.
+type UnusedNolintDirective struct {
+	// Pos is the position of the directive comment, Pos.Offset is the byte offset of its start in the file.
+	Pos token.Position
+	// EndOffset is the byte offset of the end of the directive comment in the file.
+	EndOffset int
+	// Text is the whole text of the directive comment, e.g. `//nolint:errcheck // explanation`.
+	Text string
+	// Linter is the linter the directive is unused for, it's empty if the whole directive is unused.
+	Linter string
 }
 
 type filesCache map[string]*fileData
@@ -69,20 +118,70 @@ type Nolint struct {
 	dbManager      *lintersdb.Manager
 	enabledLinters map[string]*linter.Config
 	log            logutils.Log
-
-	unknownLintersSet map[string]bool
+	directives     []string // keywords of the suppression directives, e.g. nolint or lint:ignore
+
+	unknownLintersSet  map[string]bool
+	unusedDirectives   []UnusedNolintDirective
+	warnUnknownLinters bool            // warn about each directive referencing a linter which isn't enabled
+	warnFiles          []string        // the files of the packages whose directives are checked, even without issues
+	warnedLinters      map[string]bool // the unknown linters already warned about with their positions
+
+	externalFile         string                               // name of the external nolint files, empty if none
+	reportUnusedExternal bool                                 // report the unused external directives
+	externalDirs         []string                             // directories of the packages, loaded upfront
+	externalDirectives   map[string][]externalNolintDirective // directory -> directives of its external file
 }
 
-func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters map[string]*linter.Config) *Nolint {
+// NewNolint creates the Nolint processor recognizing the directives with the given keywords:
+// `lint:ignore` uses the staticcheck syntax, the other keywords use the nolint syntax.
+// Without keywords, only `nolint` is recognized.
+func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters map[string]*linter.Config,
+	directives []string) *Nolint {
+	if len(directives) == 0 {
+		directives = []string{nolintKeyword}
+	}
+
 	return &Nolint{
-		cache:             filesCache{},
-		dbManager:         dbManager,
-		enabledLinters:    enabledLinters,
-		log:               log,
-		unknownLintersSet: map[string]bool{},
+		cache:              filesCache{},
+		dbManager:          dbManager,
+		enabledLinters:     enabledLinters,
+		log:                log,
+		directives:         directives,
+		unknownLintersSet:  map[string]bool{},
+		warnedLinters:      map[string]bool{},
+		externalDirectives: map[string][]externalNolintDirective{},
 	}
 }
 
+// WithWarnUnknownLinters makes the processor warn, with their positions, about the directives
+// referencing linters which are unknown or not enabled: such directives suppress nothing.
+// The directives of all the files of the packages are checked, not only the ones of the files with issues.
+func (p *Nolint) WithWarnUnknownLinters(enabled bool, pkgs []*packages.Package) *Nolint {
+	p.warnUnknownLinters = enabled
+	if !enabled {
+		return p
+	}
+
+	seen := map[string]bool{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.GoFiles {
+			// the same relative paths as the issues paths: the files are parsed once.
+			relPath, err := fsutils.ShortestRelPath(file, "")
+			if err != nil {
+				relPath = file
+			}
+
+			if !seen[relPath] {
+				seen[relPath] = true
+				p.warnFiles = append(p.warnFiles, relPath)
+			}
+		}
+	}
+	sort.Strings(p.warnFiles)
+
+	return p
+}
+
 var _ Processor = &Nolint{}
 
 func (p Nolint) Name() string {
@@ -90,9 +189,26 @@ func (p Nolint) Name() string {
 }
 
 func (p *Nolint) Process(issues []result.Issue) ([]result.Issue, error) {
+	if err := p.loadExternalDirs(); err != nil {
+		return nil, err
+	}
+
+	// the warnings about the directives are given while the ranges of their files are built.
+	for _, file := range p.warnFiles {
+		if _, err := p.getOrCreateFileData(&result.Issue{Pos: token.Position{Filename: file}}); err != nil {
+			return nil, err
+		}
+	}
+	p.warnFiles = nil
+
 	// put nolintlint issues last because we process other issues first to determine which nolint directives are unused
 	sort.Stable(sortWithNolintlintLast(issues))
-	return filterIssuesErr(issues, p.shouldPassIssue)
+	issues, err := filterIssuesErr(issues, p.shouldPassIssue)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(issues, p.unusedExternalIssues()...), nil
 }
 
 func (p *Nolint) getOrCreateFileData(i *result.Issue) (*fileData, error) {
@@ -108,6 +224,12 @@ func (p *Nolint) getOrCreateFileData(i *result.Issue) (*fileData, error) {
 		return nil, errors.New("no file path for issue")
 	}
 
+	externalRanges, err := p.getExternalRanges(i.FilePath())
+	if err != nil {
+		return nil, err
+	}
+	fd.ignoredRanges = externalRanges
+
 	// TODO: migrate this parsing to go/analysis facts
 	// or cache them somehow per file.
 
@@ -119,7 +241,8 @@ func (p *Nolint) getOrCreateFileData(i *result.Issue) (*fileData, error) {
 		return fd, nil
 	}
 
-	fd.ignoredRanges = p.buildIgnoredRangesForFile(f, fset, i.FilePath())
+	fd.ignoredRanges = append(p.buildIgnoredRangesForFile(f, fset, i.FilePath()), externalRanges...)
+	fd.directives = extractNolintDirectives(fset, f.Comments)
 	nolintDebugf("file %s: built nolint ranges are %+v", i.FilePath(), fd.ignoredRanges)
 	return fd, nil
 }
@@ -132,6 +255,10 @@ func (p *Nolint) buildIgnoredRangesForFile(f *ast.File, fset *token.FileSet, fil
 		return nil
 	}
 
+	for i := range inlineRanges {
+		p.warnNotEnabledLinters(filePath, inlineRanges[i].From, &inlineRanges[i])
+	}
+
 	e := rangeExpander{
 		fset:         fset,
 		inlineRanges: inlineRanges,
@@ -173,9 +300,60 @@ func (p *Nolint) shouldPassIssue(i *result.Issue) (bool, error) {
 		}
 	}
 
+	if i.FromLinter == golinters.NoLintLintName && i.ExpectNoLint {
+		p.recordUnusedDirective(fd, i)
+	}
+
 	return true, nil
 }
 
+func (p *Nolint) recordUnusedDirective(fd *fileData, i *result.Issue) {
+	for _, d := range fd.directives {
+		// a zero column means the column is unknown
+		if d.pos.Line != i.Line() || (i.Column() != 0 && d.pos.Column != i.Column()) {
+			continue
+		}
+
+		pos := d.pos
+		pos.Filename = i.FilePath()
+
+		p.unusedDirectives = append(p.unusedDirectives, UnusedNolintDirective{
+			Pos:       pos,
+			EndOffset: d.endOffset,
+			Text:      d.text,
+			Linter:    i.ExpectedNoLintLinter,
+		})
+		return
+	}
+
+	nolintDebugf("no directive found for the unused nolint issue %v", i)
+}
+
+// UnusedDirectives returns the nolint directives which didn't suppress any issue.
+// It's only filled when nolintlint reports the unused directives.
+func (p *Nolint) UnusedDirectives() []UnusedNolintDirective {
+	return p.unusedDirectives
+}
+
+func extractNolintDirectives(fset *token.FileSet, comments []*ast.CommentGroup) []nolintDirective {
+	var directives []nolintDirective
+	for _, g := range comments {
+		for _, c := range g.List {
+			if !strings.HasPrefix(c.Text, "//") || !nolintRe.MatchString(strings.TrimLeft(c.Text, "/ ")) {
+				continue
+			}
+
+			directives = append(directives, nolintDirective{
+				pos:       fset.Position(c.Pos()),
+				endOffset: fset.Position(c.End()).Offset,
+				text:      c.Text,
+			})
+		}
+	}
+
+	return directives
+}
+
 type rangeExpander struct {
 	fset           *token.FileSet
 	inlineRanges   []ignoredRange
@@ -235,24 +413,93 @@ func (p *Nolint) extractFileCommentsInlineRanges(fset *token.FileSet, comments .
 
 func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *token.FileSet) *ignoredRange {
 	text = strings.TrimLeft(text, "/ ")
-	if !nolintRe.MatchString(text) {
+
+	pos := fset.Position(g.Pos())
+	r := result.Range{From: pos.Line, To: fset.Position(g.End()).Line}
+
+	for _, keyword := range p.directives {
+		if !hasDirectiveKeyword(text, keyword) {
+			continue
+		}
+
+		if keyword == lintIgnoreKeyword {
+			return p.extractLintIgnoreRange(text, r, pos.Column)
+		}
+
+		// the other keywords have the nolint syntax
+		return p.extractNolintRange(nolintKeyword+strings.TrimPrefix(text, keyword), r, pos.Column)
+	}
+
+	return nil
+}
+
+// hasDirectiveKeyword checks that the comment text starts with the keyword followed by a space, a colon or nothing.
+func hasDirectiveKeyword(text, keyword string) bool {
+	if !strings.HasPrefix(text, keyword) {
+		return false
+	}
+
+	rest := strings.TrimPrefix(text, keyword)
+	return rest == "" || rest[0] == ' ' || rest[0] == ':'
+}
+
+func newIgnoredRange(r result.Range, col int, linters []string) *ignoredRange {
+	return &ignoredRange{
+		Range:                  r,
+		col:                    col,
+		linters:                linters,
+		matchedIssueFromLinter: make(map[string]bool),
+	}
+}
+
+// extractLintIgnoreRange parses a staticcheck-style `lint:ignore Check1[,Check2] reason` directive:
+// each check is a linter name or a check code matched with the prefix of the issue text (e.g. `SA1019: ...`).
+func (p *Nolint) extractLintIgnoreRange(text string, r result.Range, col int) *ignoredRange {
+	fields := strings.Fields(strings.TrimPrefix(text, lintIgnoreKeyword))
+	if len(fields) == 0 {
+		nolintDebugf("%d: no checks in the lint:ignore directive", r.From)
 		return nil
 	}
 
-	buildRange := func(linters []string) *ignoredRange {
-		pos := fset.Position(g.Pos())
-		return &ignoredRange{
-			Range: result.Range{
-				From: pos.Line,
-				To:   fset.Position(g.End()).Line,
-			},
-			col:                    pos.Column,
-			linters:                linters,
-			matchedIssueFromLinter: make(map[string]bool),
+	ir := newIgnoredRange(r, col, nil)
+	for _, item := range strings.Split(fields[0], ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
 		}
+
+		lcs := p.dbManager.GetLinterConfigs(strings.ToLower(item))
+		if lcs == nil {
+			ir.checks = append(ir.checks, item)
+			continue
+		}
+
+		for _, lc := range lcs {
+			ir.linters = append(ir.linters, lc.Name()) // normalize name to work with aliases
+		}
+	}
+
+	if len(ir.linters) == 0 && len(ir.checks) == 0 {
+		return nil
 	}
 
-	if strings.HasPrefix(text, "nolint:all") || !strings.HasPrefix(text, "nolint:") {
+	nolintDebugf("%d: lint:ignore linters are %s, checks are %s", r.From, ir.linters, ir.checks)
+	return ir
+}
+
+func (p *Nolint) extractNolintRange(text string, r result.Range, col int) *ignoredRange {
+	buildRange := func(linters []string) *ignoredRange {
+		return newIgnoredRange(r, col, linters)
+	}
+
+	if strings.HasPrefix(text, "nolint:all") {
+		// ignore all linters, except the ones listed by an optional `except:` clause
+		ir := buildRange(nil)
+		ir.exceptLinters = p.extractExceptLinters(text, r.From)
+		return ir
+	}
+
+	if !strings.HasPrefix(text, "nolint:") {
 		return buildRange(nil) // ignore all linters
 	}
 
@@ -271,7 +518,7 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 		if lcs == nil {
 			p.unknownLintersSet[linterName] = true
 			linters = append(linters, linterName)
-			nolintDebugf("unknown linter %s on line %d", linterName, fset.Position(g.Pos()).Line)
+			nolintDebugf("unknown linter %s on line %d", linterName, r.From)
 			continue
 		}
 
@@ -280,18 +527,74 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 		}
 	}
 
-	nolintDebugf("%d: linters are %s", fset.Position(g.Pos()).Line, linters)
+	nolintDebugf("%d: linters are %s", r.From, linters)
 	return buildRange(linters)
 }
 
-func (p Nolint) Finish() {
-	if len(p.unknownLintersSet) == 0 {
+// extractExceptLinters returns the normalized names of the linters listed
+// by the `except:` clause of a `nolint:all except:linter1,linter2` directive.
+func (p *Nolint) extractExceptLinters(text string, line int) []string {
+	text = strings.Split(text, "//")[0] // allow another comment after this comment
+
+	clause := strings.TrimSpace(strings.TrimPrefix(text, "nolint:all"))
+	if !strings.HasPrefix(clause, "except:") {
+		return nil
+	}
+
+	var linters []string
+	for _, item := range strings.Split(strings.TrimPrefix(clause, "except:"), ",") {
+		linterName := strings.ToLower(strings.TrimSpace(item))
+		if linterName == "" {
+			continue
+		}
+
+		lcs := p.dbManager.GetLinterConfigs(linterName)
+		if lcs == nil {
+			p.unknownLintersSet[linterName] = true
+			nolintDebugf("unknown linter %s in except clause on line %d", linterName, line)
+			continue
+		}
+
+		for _, lc := range lcs {
+			linters = append(linters, lc.Name()) // normalize name to work with aliases
+		}
+	}
+
+	nolintDebugf("%d: except linters are %s", line, linters)
+	return linters
+}
+
+// warnNotEnabledLinters warns about the linters of the directive at the position which are not enabled.
+func (p *Nolint) warnNotEnabledLinters(filePath string, line int, ir *ignoredRange) {
+	if !p.warnUnknownLinters {
 		return
 	}
 
+	for _, linters := range [][]string{ir.linters, ir.exceptLinters} {
+		for _, name := range linters {
+			if p.enabledLinters[name] != nil {
+				continue
+			}
+
+			if p.dbManager.GetLinterConfigs(name) == nil {
+				p.warnedLinters[name] = true
+				p.log.Warnf("%s:%d: the nolint directive references the unknown linter %q", filePath, line, name)
+			} else {
+				p.log.Warnf("%s:%d: the nolint directive references the linter %q which is not enabled", filePath, line, name)
+			}
+		}
+	}
+}
+
+func (p Nolint) Finish() {
 	unknownLinters := make([]string, 0, len(p.unknownLintersSet))
 	for name := range p.unknownLintersSet {
-		unknownLinters = append(unknownLinters, name)
+		if !p.warnedLinters[name] { // not warned about twice
+			unknownLinters = append(unknownLinters, name)
+		}
+	}
+	if len(unknownLinters) == 0 {
+		return
 	}
 	sort.Strings(unknownLinters)
 
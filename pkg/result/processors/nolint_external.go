@@ -0,0 +1,266 @@
+package processors
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/golinters"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// externalNolintDirective is a directive of an external nolint file (see Nolint.WithExternalFile),
+// one per line: `<file>:<line>[-<line>] <directive>`, e.g. `gen.go:10-20 nolint:errcheck // generated`.
+// The file is a file of the directory of the external file, the directive has the nolint syntax.
+type externalNolintDirective struct {
+	pos       token.Position // start of the line in the external file
+	endOffset int
+	text      string // the whole line
+	directive string // the nolint directive, e.g. `nolint:errcheck // generated`
+	file      string // base name of the file the directive applies to
+	ir        ignoredRange
+}
+
+// WithExternalFile sets the name of the external nolint files: the directives of such a file in a directory
+// apply to the files of this directory as if they were inline, e.g. for the generated files.
+// The unused external directives are reported as nolintlint issues if reportUnused is set:
+// the external files of the directories of the packages are loaded even if the directories have no issues.
+func (p *Nolint) WithExternalFile(name string, reportUnused bool, pkgs []*packages.Package) *Nolint {
+	p.externalFile = name
+	p.reportUnusedExternal = reportUnused
+
+	if name == "" {
+		return p
+	}
+
+	dirs := map[string]bool{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.GoFiles {
+			// the same relative paths as the issues paths
+			relPath, err := fsutils.ShortestRelPath(file, "")
+			if err != nil {
+				relPath = file
+			}
+
+			dir := filepath.Dir(relPath)
+			if !dirs[dir] {
+				dirs[dir] = true
+				p.externalDirs = append(p.externalDirs, dir)
+			}
+		}
+	}
+
+	return p
+}
+
+// loadExternalDirs loads the external files of the directories of the packages.
+func (p *Nolint) loadExternalDirs() error {
+	for _, dir := range p.externalDirs {
+		if _, err := p.getExternalDirectives(dir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getExternalDirectives returns the directives of the external file of the directory, loaded once.
+func (p *Nolint) getExternalDirectives(dir string) ([]externalNolintDirective, error) {
+	if directives, ok := p.externalDirectives[dir]; ok {
+		return directives, nil
+	}
+
+	directives, err := p.loadExternalFile(filepath.Join(dir, p.externalFile))
+	if err != nil {
+		return nil, err
+	}
+
+	p.externalDirectives[dir] = directives
+	return directives, nil
+}
+
+// getExternalRanges returns the ranges of the external directives applying to the file.
+func (p *Nolint) getExternalRanges(filePath string) ([]ignoredRange, error) {
+	if p.externalFile == "" {
+		return nil, nil
+	}
+
+	directives, err := p.getExternalDirectives(filepath.Dir(filePath))
+	if err != nil {
+		return nil, err
+	}
+
+	var ranges []ignoredRange
+	for _, d := range directives {
+		if d.file == filepath.Base(filePath) {
+			ranges = append(ranges, d.ir)
+		}
+	}
+
+	return ranges, nil
+}
+
+func (p *Nolint) loadExternalFile(path string) ([]externalNolintDirective, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the nolint file: %w", err)
+	}
+
+	var directives []externalNolintDirective
+	offset := 0
+	for ind, rawLine := range bytes.Split(content, []byte("\n")) {
+		lineOffset := offset
+		offset += len(rawLine) + 1
+
+		line := string(bytes.TrimSuffix(rawLine, []byte("\r")))
+		text := strings.TrimSpace(line)
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		pos := token.Position{Filename: path, Offset: lineOffset, Line: ind + 1, Column: 1}
+
+		d, err := p.parseExternalDirective(text)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid nolint directive `%s`: %w", pos, text, err)
+		}
+
+		d.pos = pos
+		d.endOffset = lineOffset + len(line)
+		d.text = line
+		directives = append(directives, *d)
+
+		p.warnNotEnabledLinters(path, pos.Line, &d.ir)
+	}
+
+	nolintDebugf("nolint file %s: directives are %+v", path, directives)
+	return directives, nil
+}
+
+func (p *Nolint) parseExternalDirective(text string) (*externalNolintDirective, error) {
+	fields := strings.SplitN(text, " ", 2)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("expected `<file>:<line>[-<line>] <directive>`")
+	}
+
+	location, directive := fields[0], strings.TrimSpace(fields[1])
+
+	sep := strings.LastIndex(location, ":")
+	if sep <= 0 {
+		return nil, fmt.Errorf("no file in %q", location)
+	}
+
+	file := location[:sep]
+	if strings.ContainsAny(file, `/\`) {
+		return nil, fmt.Errorf("the file %q isn't in the directory of the nolint file", file)
+	}
+
+	r, err := parseExternalLines(location[sep+1:])
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasDirectiveKeyword(directive, nolintKeyword) {
+		return nil, fmt.Errorf("the directive doesn't start with %s", nolintKeyword)
+	}
+
+	return &externalNolintDirective{
+		directive: directive,
+		file:      file,
+		ir:        *p.extractNolintRange(directive, r, 0),
+	}, nil
+}
+
+// parseExternalLines parses the `<line>[-<line>]` lines of an external directive.
+func parseExternalLines(lines string) (result.Range, error) {
+	from, to, isRange := strings.Cut(lines, "-")
+
+	fromLine, err := strconv.Atoi(from)
+	if err != nil || fromLine < 1 {
+		return result.Range{}, fmt.Errorf("invalid line %q", from)
+	}
+
+	if !isRange {
+		return result.Range{From: fromLine, To: fromLine}, nil
+	}
+
+	toLine, err := strconv.Atoi(to)
+	if err != nil || toLine < fromLine {
+		return result.Range{}, fmt.Errorf("invalid line range %q", lines)
+	}
+
+	return result.Range{From: fromLine, To: toLine}, nil
+}
+
+// unusedExternalIssues returns the nolintlint issues of the external directives which don't suppress any issue,
+// and records them as unused directives.
+func (p *Nolint) unusedExternalIssues() []result.Issue {
+	if !p.reportUnusedExternal {
+		return nil
+	}
+
+	dirs := make([]string, 0, len(p.externalDirectives))
+	for dir := range p.externalDirectives {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	var issues []result.Issue
+	for _, dir := range dirs {
+		for _, d := range p.externalDirectives[dir] {
+			for _, linterName := range p.unusedExternalLinters(&d.ir) {
+				text := fmt.Sprintf("directive `%s` is unused", d.directive)
+				if linterName != "" {
+					text += fmt.Sprintf(" for linter %q", linterName)
+				}
+
+				issues = append(issues, result.Issue{
+					FromLinter: golinters.NoLintLintName,
+					Text:       text,
+					Pos:        d.pos,
+				})
+
+				p.unusedDirectives = append(p.unusedDirectives, UnusedNolintDirective{
+					Pos:       d.pos,
+					EndOffset: d.endOffset,
+					Text:      d.text,
+					Linter:    linterName,
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+// unusedExternalLinters returns the enabled linters of the directive which matched no issue,
+// or an empty name if the directive is for all the linters and matched no issue.
+func (p *Nolint) unusedExternalLinters(ir *ignoredRange) []string {
+	if len(ir.linters) == 0 {
+		if len(ir.matchedIssueFromLinter) == 0 {
+			return []string{""}
+		}
+		return nil
+	}
+
+	var linters []string
+	for _, linterName := range ir.linters {
+		// don't expect disabled linters to cover their directives
+		if p.enabledLinters[linterName] != nil && !ir.matchedIssueFromLinter[linterName] {
+			linters = append(linters, linterName)
+		}
+	}
+
+	return linters
+}
@@ -0,0 +1,126 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/golinters"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const externalNolintTestFile = ".golangci-nolint"
+
+func newExternalNolintProcessor(t *testing.T, reportUnused bool, pkgs []*packages.Package) *Nolint {
+	enabledLinters := []string{"errcheck", "govet", "nolintlint"}
+
+	enabledSetLog := logutils.NewMockLog()
+	enabledSetLog.On("Infof", "Active %d linters: %s", len(enabledLinters), enabledLinters)
+	cfg := &config.Config{Linters: config.Linters{DisableAll: true, Enable: enabledLinters}}
+	dbManager := lintersdb.NewManager(cfg, nil)
+	enabledLintersSet := lintersdb.NewEnabledSet(dbManager, lintersdb.NewValidator(dbManager), enabledSetLog, cfg)
+	enabledLintersMap, err := enabledLintersSet.GetEnabledLintersMap()
+	require.NoError(t, err)
+
+	return NewNolint(getMockLog(), dbManager, enabledLintersMap, nil).
+		WithExternalFile(externalNolintTestFile, reportUnused, pkgs)
+}
+
+func writeExternalNolintDir(t *testing.T, directives string) string {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gen.go"), []byte("package p\n\nvar a, b = 1, 2\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.go"), []byte("package p\n\nvar c, d = 1, 2\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, externalNolintTestFile), []byte(directives), 0o600))
+	return dir
+}
+
+func newExternalNolintIssue(file string, line int, fromLinter string) result.Issue {
+	return result.Issue{
+		Pos:        token.Position{Filename: file, Line: line},
+		FromLinter: fromLinter,
+	}
+}
+
+func TestNolintExternalFile(t *testing.T) {
+	dir := writeExternalNolintDir(t, "# generated code\ngen.go:3-4 nolint:errcheck // generated\n")
+	gen, other := filepath.Join(dir, "gen.go"), filepath.Join(dir, "other.go")
+
+	p := newExternalNolintProcessor(t, false, nil)
+
+	processAssertEmpty(t, p, newExternalNolintIssue(gen, 3, "errcheck"))
+	processAssertEmpty(t, p, newExternalNolintIssue(gen, 4, "errcheck"))
+	processAssertSame(t, p, newExternalNolintIssue(gen, 3, "govet"))    // another linter
+	processAssertSame(t, p, newExternalNolintIssue(gen, 5, "errcheck")) // another line
+	processAssertSame(t, p, newExternalNolintIssue(other, 3, "errcheck"))
+}
+
+func TestNolintExternalFileUnused(t *testing.T) {
+	content := "gen.go:3 nolint:errcheck,govet\r\ngen.go:8 nolint\r\n"
+	dir := writeExternalNolintDir(t, content)
+	gen, nolintFile := filepath.Join(dir, "gen.go"), filepath.Join(dir, externalNolintTestFile)
+
+	p := newExternalNolintProcessor(t, true, nil)
+
+	processedIssues := process(t, p, newExternalNolintIssue(gen, 3, "errcheck"))
+
+	expectedIssues := []result.Issue{
+		{
+			FromLinter: golinters.NoLintLintName,
+			Text:       "directive `nolint:errcheck,govet` is unused for linter \"govet\"",
+			Pos:        token.Position{Filename: nolintFile, Offset: 0, Line: 1, Column: 1},
+		},
+		{
+			FromLinter: golinters.NoLintLintName,
+			Text:       "directive `nolint` is unused",
+			Pos:        token.Position{Filename: nolintFile, Offset: 32, Line: 2, Column: 1},
+		},
+	}
+	assert.Equal(t, expectedIssues, processedIssues)
+
+	edits := GetNolintPruneEdits(p.UnusedDirectives())
+	pruned, err := ApplyNolintEdits([]byte(content), edits[nolintFile])
+	require.NoError(t, err)
+	assert.Equal(t, "gen.go:3 nolint:errcheck\r\n", string(pruned))
+}
+
+func TestNolintExternalFileUnusedWithoutIssues(t *testing.T) {
+	dir := writeExternalNolintDir(t, "gen.go:3 nolint:errcheck\n")
+
+	p := newExternalNolintProcessor(t, true, []*packages.Package{{GoFiles: []string{filepath.Join(dir, "gen.go")}}})
+
+	processedIssues := process(t, p)
+	require.Len(t, processedIssues, 1)
+	assert.Equal(t, "directive `nolint:errcheck` is unused for linter \"errcheck\"", processedIssues[0].Text)
+}
+
+func TestNolintExternalFileInvalid(t *testing.T) {
+	testCases := []string{
+		"gen.go nolint",
+		"gen.go:3",
+		":3 nolint",
+		"sub/gen.go:3 nolint",
+		"gen.go:0 nolint",
+		"gen.go:5-3 nolint",
+		"gen.go:3 errcheck",
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc, func(t *testing.T) {
+			dir := writeExternalNolintDir(t, tc+"\n")
+
+			p := newExternalNolintProcessor(t, false, nil)
+
+			_, err := p.Process([]result.Issue{newExternalNolintIssue(filepath.Join(dir, "gen.go"), 3, "errcheck")})
+			assert.Error(t, err)
+		})
+	}
+}
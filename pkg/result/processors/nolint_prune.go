@@ -0,0 +1,171 @@
+package processors
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+// NolintEdit is the edit of a file pruning an unused nolint directive:
+// the directive is removed, or rewritten without its unused linters.
+type NolintEdit struct {
+	Pos       token.Position // position of the directive comment
+	EndOffset int
+	OldText   string
+	NewText   string // empty if the directive is removed
+}
+
+func (e NolintEdit) String() string {
+	if e.NewText == "" {
+		return fmt.Sprintf("%s: remove `%s`", e.Pos, e.OldText)
+	}
+	return fmt.Sprintf("%s: rewrite `%s` to `%s`", e.Pos, e.OldText, e.NewText)
+}
+
+// GetNolintPruneEdits returns the edits pruning the unused directives, grouped by file and sorted by position.
+func GetNolintPruneEdits(directives []UnusedNolintDirective) map[string][]NolintEdit {
+	type directiveKey struct {
+		file   string
+		offset int
+	}
+
+	byDirective := map[directiveKey][]UnusedNolintDirective{}
+	var keys []directiveKey
+	for _, d := range directives {
+		key := directiveKey{file: d.Pos.Filename, offset: d.Pos.Offset}
+		if _, ok := byDirective[key]; !ok {
+			keys = append(keys, key)
+		}
+		byDirective[key] = append(byDirective[key], d)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].file != keys[j].file {
+			return keys[i].file < keys[j].file
+		}
+		return keys[i].offset < keys[j].offset
+	})
+
+	edits := map[string][]NolintEdit{}
+	for _, key := range keys {
+		ds := byDirective[key]
+
+		edit := NolintEdit{
+			Pos:       ds[0].Pos,
+			EndOffset: ds[0].EndOffset,
+			OldText:   ds[0].Text,
+		}
+
+		unusedLinters := map[string]bool{}
+		for _, d := range ds {
+			if d.Linter == "" {
+				// the whole directive is unused
+				unusedLinters = nil
+				break
+			}
+			unusedLinters[strings.ToLower(d.Linter)] = true
+		}
+
+		if unusedLinters != nil {
+			edit.NewText = rewriteNolintDirective(edit.OldText, unusedLinters)
+		}
+
+		edits[key.file] = append(edits[key.file], edit)
+	}
+
+	return edits
+}
+
+// rewriteNolintDirective returns the directive without the unused linters,
+// or an empty string if no linter remains.
+func rewriteNolintDirective(text string, unusedLinters map[string]bool) string {
+	const prefix = "nolint:"
+
+	idx := strings.Index(text, prefix)
+	if idx == -1 {
+		return ""
+	}
+
+	// `//nolint:linter1,linter2 // explanation`
+	list, explanation := text[idx+len(prefix):], ""
+	if i := strings.Index(list, "//"); i != -1 {
+		list, explanation = list[:i], list[i:]
+	}
+	trailingSpace := list[len(strings.TrimRight(list, " \t")):]
+
+	var remaining []string
+	for _, item := range strings.Split(list, ",") {
+		linterName := strings.TrimSpace(item)
+		if linterName == "" || unusedLinters[strings.ToLower(linterName)] {
+			continue
+		}
+		remaining = append(remaining, linterName)
+	}
+
+	if len(remaining) == 0 {
+		return ""
+	}
+
+	if explanation != "" && trailingSpace == "" {
+		trailingSpace = " "
+	}
+
+	return text[:idx+len(prefix)] + strings.Join(remaining, ",") + trailingSpace + explanation
+}
+
+// ApplyNolintEdits applies the edits of a file to its content.
+// It fails without modifying anything if an edited directive isn't found at its position:
+// the file has changed since it was analyzed.
+func ApplyNolintEdits(content []byte, edits []NolintEdit) ([]byte, error) {
+	sorted := append([]NolintEdit{}, edits...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Pos.Offset > sorted[j].Pos.Offset // apply from the end to keep offsets valid
+	})
+
+	res := append([]byte{}, content...)
+	for _, e := range sorted {
+		start, end := e.Pos.Offset, e.EndOffset
+		if start < 0 || end > len(res) || start > end || string(res[start:end]) != e.OldText {
+			return nil, fmt.Errorf("directive `%s` not found at %s: the file has changed", e.OldText, e.Pos)
+		}
+
+		if e.NewText != "" {
+			res = append(res[:start], append([]byte(e.NewText), res[end:]...)...)
+			continue
+		}
+
+		start, end = expandRemovedCommentRange(res, start, end)
+		res = append(res[:start], res[end:]...)
+	}
+
+	return res, nil
+}
+
+// expandRemovedCommentRange expands the range of a removed comment:
+// to the whole line if the comment is alone on its line,
+// to the whitespaces before it if it trails some code.
+func expandRemovedCommentRange(content []byte, start, end int) (int, int) {
+	lineStart := bytes.LastIndexByte(content[:start], '\n') + 1
+
+	codeEnd := start
+	for codeEnd > lineStart && (content[codeEnd-1] == ' ' || content[codeEnd-1] == '\t') {
+		codeEnd--
+	}
+
+	if codeEnd != lineStart {
+		// trailing comment: keep the code
+		return codeEnd, end
+	}
+
+	// whole-line comment: remove the line with its line break
+	if end < len(content) && content[end] == '\r' {
+		end++
+	}
+	if end < len(content) && content[end] == '\n' {
+		end++
+	}
+
+	return lineStart, end
+}
@@ -0,0 +1,98 @@
+package processors
+
+import (
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nolintPruneSource = `package foo
+
+//nolint:errcheck // whole-line directive
+func foo() {
+	bar() //nolint:errcheck,gosec // trailing directive
+	bar() //nolint
+}
+`
+
+// newUnusedNolintDirective returns the directive with the given text on the line of the test source.
+func newUnusedNolintDirective(t *testing.T, line int, text, linter string) UnusedNolintDirective {
+	t.Helper()
+
+	lines := strings.SplitAfter(nolintPruneSource, "\n")
+
+	lineStart := len(strings.Join(lines[:line-1], ""))
+	col := strings.Index(lines[line-1], text)
+	require.NotEqual(t, -1, col, "directive %q not found on line %d", text, line)
+
+	return UnusedNolintDirective{
+		Pos: token.Position{
+			Filename: "foo.go",
+			Offset:   lineStart + col,
+			Line:     line,
+			Column:   col + 1,
+		},
+		EndOffset: lineStart + col + len(text),
+		Text:      text,
+		Linter:    linter,
+	}
+}
+
+func TestRewriteNolintDirective(t *testing.T) {
+	testCases := []struct {
+		text     string
+		unused   []string
+		expected string
+	}{
+		{text: "//nolint:errcheck", unused: []string{"errcheck"}, expected: ""},
+		{text: "//nolint:errcheck,gosec", unused: []string{"errcheck"}, expected: "//nolint:gosec"},
+		{text: "//nolint:errcheck, gosec // why", unused: []string{"gosec"}, expected: "//nolint:errcheck // why"},
+		{text: "//nolint:errcheck,gosec,lll// why", unused: []string{"gosec"}, expected: "//nolint:errcheck,lll // why"},
+		{text: "//nolint:ErrCheck,gosec", unused: []string{"errcheck", "gosec"}, expected: ""},
+	}
+
+	for _, tc := range testCases {
+		unused := map[string]bool{}
+		for _, l := range tc.unused {
+			unused[l] = true
+		}
+
+		assert.Equal(t, tc.expected, rewriteNolintDirective(tc.text, unused), tc.text)
+	}
+}
+
+func TestNolintPrune(t *testing.T) {
+	directives := []UnusedNolintDirective{
+		newUnusedNolintDirective(t, 6, "//nolint", ""),
+		newUnusedNolintDirective(t, 5, "//nolint:errcheck,gosec // trailing directive", "gosec"),
+		newUnusedNolintDirective(t, 3, "//nolint:errcheck // whole-line directive", "errcheck"),
+	}
+
+	edits := GetNolintPruneEdits(directives)
+	require.Len(t, edits, 1)
+	require.Len(t, edits["foo.go"], 3)
+
+	content, err := ApplyNolintEdits([]byte(nolintPruneSource), edits["foo.go"])
+	require.NoError(t, err)
+
+	expected := `package foo
+
+func foo() {
+	bar() //nolint:errcheck // trailing directive
+	bar()
+}
+`
+	assert.Equal(t, expected, string(content))
+}
+
+func TestNolintPruneChangedFile(t *testing.T) {
+	edits := GetNolintPruneEdits([]UnusedNolintDirective{
+		newUnusedNolintDirective(t, 3, "//nolint:errcheck // whole-line directive", ""),
+	})
+
+	_, err := ApplyNolintEdits([]byte("package foo\n"), edits["foo.go"])
+	assert.Error(t, err)
+}
@@ -8,9 +8,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/golinters"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
@@ -33,7 +36,7 @@ func newNolint2FileIssue(line int) result.Issue {
 }
 
 func newTestNolintProcessor(log logutils.Log) *Nolint {
-	return NewNolint(log, lintersdb.NewManager(nil, nil), nil)
+	return NewNolint(log, lintersdb.NewManager(nil, nil), nil, nil)
 }
 
 func getMockLog() *logutils.MockLog {
@@ -178,6 +181,78 @@ func TestNolintInvalidLinterNameWithViolationOnTheSameLine(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNolintAllExcept(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint_all_except.go")
+	newIssue := func(line int, fromLinter string) result.Issue {
+		return result.Issue{
+			Pos: token.Position{
+				Filename: fileName,
+				Line:     line,
+			},
+			FromLinter: fromLinter,
+		}
+	}
+
+	log := getMockLog()
+	log.On("Warnf", "Found unknown linters in //nolint directives: %s", "unknown")
+
+	p := newTestNolintProcessor(log)
+
+	processAssertSame(t, p, newIssue(8, "errcheck"))
+	processAssertSame(t, p, newIssue(8, "gosec")) // alias
+	processAssertEmpty(t, p, newIssue(8, "govet"))
+
+	processAssertEmpty(t, p, newIssue(9, "errcheck")) // unknown linter is ignored
+
+	processAssertEmpty(t, p, newIssue(10, "errcheck"))
+	processAssertEmpty(t, p, newIssue(10, "gosec"))
+
+	p.Finish()
+}
+
+func TestNolintAllExceptUnused(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint_all_except.go")
+
+	// the nolintlint issue that would be generated for the directive with the except clause
+	nolintlintIssue := result.Issue{
+		Pos: token.Position{
+			Filename: fileName,
+			Line:     8,
+		},
+		FromLinter:   golinters.NoLintLintName,
+		ExpectNoLint: true,
+	}
+
+	log := getMockLog()
+	log.On("Warnf", "Found unknown linters in //nolint directives: %s", "unknown")
+
+	t.Run("when only excepted linters report issues, the directive is unused", func(t *testing.T) {
+		p := newTestNolintProcessor(log)
+		defer p.Finish()
+
+		processAssertSame(t, p, []result.Issue{{
+			Pos: token.Position{
+				Filename: fileName,
+				Line:     8,
+			},
+			FromLinter: "errcheck",
+		}, nolintlintIssue}...)
+	})
+
+	t.Run("when another linter reports an issue, the directive is used", func(t *testing.T) {
+		p := newTestNolintProcessor(log)
+		defer p.Finish()
+
+		processAssertEmpty(t, p, []result.Issue{{
+			Pos: token.Position{
+				Filename: fileName,
+				Line:     8,
+			},
+			FromLinter: "govet",
+		}, nolintlintIssue}...)
+	})
+}
+
 func TestNolintAliases(t *testing.T) {
 	p := newTestNolintProcessor(getMockLog())
 	for _, line := range []int{47, 49, 51} {
@@ -284,7 +359,7 @@ func TestNolintUnused(t *testing.T) {
 		enabledLintersSet := lintersdb.NewEnabledSet(dbManager, lintersdb.NewValidator(dbManager), enabledSetLog, cfg)
 		enabledLintersMap, err := enabledLintersSet.GetEnabledLintersMap()
 		assert.NoError(t, err)
-		return NewNolint(log, dbManager, enabledLintersMap)
+		return NewNolint(log, dbManager, enabledLintersMap, nil)
 	}
 
 	// the issue below is the nolintlint issue that would be generated for the test file
@@ -316,6 +391,26 @@ func TestNolintUnused(t *testing.T) {
 		processAssertSame(t, p, nolintlintIssueVarcheck)
 	})
 
+	t.Run("when an issue does not occur, the directive is reported as unused", func(t *testing.T) {
+		p := createProcessor(t, log, []string{"nolintlint", "varcheck"})
+		defer p.Finish()
+
+		processAssertSame(t, p, nolintlintIssueVarcheck)
+
+		expected := []UnusedNolintDirective{{
+			Pos: token.Position{
+				Filename: fileName,
+				Offset:   41,
+				Line:     3,
+				Column:   24,
+			},
+			EndOffset: 58,
+			Text:      "//nolint:varcheck",
+			Linter:    "varcheck",
+		}}
+		assert.Equal(t, expected, p.UnusedDirectives())
+	})
+
 	t.Run("when an issue does not occur but nolintlint is nolinted, it is removed from the nolintlint issues", func(t *testing.T) {
 		p := createProcessor(t, log, []string{"nolintlint", "varcheck"})
 		defer p.Finish()
@@ -346,9 +441,110 @@ func TestNolintUnused(t *testing.T) {
 
 		enabledLintersMap, err := enabledLintersSet.GetEnabledLintersMap()
 		assert.NoError(t, err)
-		p := NewNolint(log, dbManager, enabledLintersMap)
+		p := NewNolint(log, dbManager, enabledLintersMap, nil)
 		defer p.Finish()
 
 		processAssertEmpty(t, p, nolintlintIssueVarcheck)
 	})
 }
+
+func TestNolintDirectives(t *testing.T) {
+	newIssue := func(line int, fromLinter, text string) result.Issue {
+		return result.Issue{
+			Pos: token.Position{
+				Filename: filepath.Join("testdata", "nolint_directives.go"),
+				Line:     line,
+			},
+			FromLinter: fromLinter,
+			Text:       text,
+		}
+	}
+
+	t.Run("default", func(t *testing.T) {
+		p := NewNolint(getMockLog(), lintersdb.NewManager(nil, nil), nil, nil)
+		defer p.Finish()
+
+		processAssertSame(t, p, newIssue(8, "errcheck", "error is not checked"))
+		processAssertSame(t, p, newIssue(11, "errcheck", "error is not checked"))
+		processAssertEmpty(t, p, newIssue(13, "errcheck", "error is not checked"))
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		p := NewNolint(getMockLog(), lintersdb.NewManager(nil, nil), nil, []string{"lint:ignore", "mylint"})
+		defer p.Finish()
+
+		// lint:ignore with a linter name
+		processAssertEmpty(t, p, newIssue(8, "errcheck", "error is not checked"))
+		processAssertSame(t, p, newIssue(8, "gosec", "G104: Errors unhandled."))
+
+		// lint:ignore with a check code and a linter name
+		processAssertEmpty(t, p, newIssue(9, "staticcheck", "SA4006: this value is never used"))
+		processAssertSame(t, p, newIssue(9, "staticcheck", "SA4017: pure function result is unused"))
+		processAssertEmpty(t, p, newIssue(9, "gosec", "G104: Errors unhandled."))
+
+		withCheckID := newIssue(9, "staticcheck", "this value is never used")
+		withCheckID.CheckID = "SA4006"
+		processAssertEmpty(t, p, withCheckID)
+
+		withCheckID.CheckID = "SA4017"
+		processAssertSame(t, p, withCheckID)
+
+		// lint:ignore without checks
+		processAssertSame(t, p, newIssue(10, "errcheck", "error is not checked"))
+
+		// custom keyword with the nolint syntax
+		processAssertEmpty(t, p, newIssue(11, "errcheck", "error is not checked"))
+		processAssertSame(t, p, newIssue(11, "gosec", "G104: Errors unhandled."))
+		processAssertSame(t, p, newIssue(12, "errcheck", "error is not checked")) // not the keyword
+
+		// nolint isn't listed
+		processAssertSame(t, p, newIssue(13, "errcheck", "error is not checked"))
+	})
+}
+
+func TestNolintWarnUnknownLinters(t *testing.T) {
+	dbManager := lintersdb.NewManager(nil, nil)
+	enabledLinters := map[string]*linter.Config{"errcheck": dbManager.GetLinterConfigs("errcheck")[0]}
+
+	fileName := filepath.Join("testdata", "nolint_bad_names.go")
+
+	// the unknown linters warned about with their positions aren't in the summary of Finish.
+	log := getMockLog()
+	log.On("Warnf", "%s:%d: the nolint directive references the unknown linter %q", fileName, 10, "bad1").Once()
+	log.On("Warnf", "%s:%d: the nolint directive references the unknown linter %q", fileName, 13, "bad2").Once()
+	log.On("Warnf", "%s:%d: the nolint directive references the unknown linter %q", fileName, 22, "bad1").Once()
+	log.On("Warnf", "%s:%d: the nolint directive references the linter %q which is not enabled",
+		fileName, 22, "ineffassign").Once()
+
+	p := NewNolint(log, dbManager, enabledLinters, nil).WithWarnUnknownLinters(true, nil)
+	processAssertEmpty(t, p, result.Issue{Pos: token.Position{Filename: fileName, Line: 10}, FromLinter: "errcheck"})
+	p.Finish()
+
+	log.AssertExpectations(t)
+}
+
+func TestNolintWarnUnknownLintersFilesWithoutIssues(t *testing.T) {
+	dbManager := lintersdb.NewManager(nil, nil)
+	enabledLinters := map[string]*linter.Config{"errcheck": dbManager.GetLinterConfigs("errcheck")[0]}
+
+	fileName := filepath.Join("testdata", "nolint_bad_names.go")
+	absPath, err := filepath.Abs(fileName)
+	require.NoError(t, err)
+
+	log := getMockLog()
+	log.On("Warnf", "%s:%d: the nolint directive references the unknown linter %q", fileName, 10, "bad1").Once()
+	log.On("Warnf", "%s:%d: the nolint directive references the unknown linter %q", fileName, 13, "bad2").Once()
+	log.On("Warnf", "%s:%d: the nolint directive references the unknown linter %q", fileName, 22, "bad1").Once()
+	log.On("Warnf", "%s:%d: the nolint directive references the linter %q which is not enabled",
+		fileName, 22, "ineffassign").Once()
+
+	pkgs := []*packages.Package{{GoFiles: []string{absPath}}}
+	p := NewNolint(log, dbManager, enabledLinters, nil).WithWarnUnknownLinters(true, pkgs)
+
+	// no issue in the file: its directives are checked anyway, once.
+	processAssertEmpty(t, p)
+	processAssertEmpty(t, p, result.Issue{Pos: token.Position{Filename: fileName, Line: 10}, FromLinter: "errcheck"})
+	p.Finish()
+
+	log.AssertExpectations(t)
+}
@@ -0,0 +1,87 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// NoopFixes drops the issues whose suggested replacement is identical to the source it replaces:
+// some analyzers report such spurious fixes.
+// The issues without replacement are kept.
+type NoopFixes struct {
+	enabled   bool
+	lineCache *fsutils.LineCache
+	log       logutils.Log
+}
+
+var _ Processor = NoopFixes{}
+
+func NewNoopFixes(enabled bool, lineCache *fsutils.LineCache, log logutils.Log) *NoopFixes {
+	return &NoopFixes{
+		enabled:   enabled,
+		lineCache: lineCache,
+		log:       log,
+	}
+}
+
+func (p NoopFixes) Name() string {
+	return "noop_fixes"
+}
+
+func (p NoopFixes) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		if i.Replacement == nil {
+			return true
+		}
+
+		return !p.isNoopFix(i)
+	}), nil
+}
+
+func (p NoopFixes) isNoopFix(i *result.Issue) bool {
+	r := i.Replacement
+
+	if r.Inline != nil {
+		line, err := p.lineCache.GetLine(i.FilePath(), i.Line())
+		if err != nil {
+			p.log.Warnf("Failed to get line %d for file %s: %s", i.Line(), i.FilePath(), err)
+			return false
+		}
+
+		if r.Inline.StartCol < 0 || r.Inline.Length < 0 || r.Inline.StartCol+r.Inline.Length > len(line) {
+			return false // invalid: the fixer reports it
+		}
+
+		return line[r.Inline.StartCol:r.Inline.StartCol+r.Inline.Length] == r.Inline.NewString
+	}
+
+	if r.NeedOnlyDelete {
+		return false
+	}
+
+	lineRange := i.GetLineRange()
+	if len(r.NewLines) != lineRange.To-lineRange.From+1 {
+		return false
+	}
+
+	for lineNumber := lineRange.From; lineNumber <= lineRange.To; lineNumber++ {
+		line, err := p.lineCache.GetLine(i.FilePath(), lineNumber)
+		if err != nil {
+			p.log.Warnf("Failed to get line %d for file %s: %s", lineNumber, i.FilePath(), err)
+			return false
+		}
+
+		if line != r.NewLines[lineNumber-lineRange.From] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p NoopFixes) Finish() {}
@@ -0,0 +1,75 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const noopFixesSource = `package foo
+
+func foo() {
+	x := 1
+	_ = x
+}
+`
+
+func newNoopFixesTestIssue(path string, lineRange result.Range, r *result.Replacement) result.Issue {
+	return result.Issue{
+		FromLinter:  "linter",
+		Text:        "text",
+		Pos:         token.Position{Filename: path, Line: lineRange.From},
+		LineRange:   &lineRange,
+		Replacement: r,
+	}
+}
+
+func TestNoopFixes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "foo.go")
+	require.NoError(t, os.WriteFile(path, []byte(noopFixesSource), 0o600))
+
+	p := NewNoopFixes(true, fsutils.NewLineCache(fsutils.NewFileCache()), logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertEmpty(t, p,
+		newNoopFixesTestIssue(path, result.Range{From: 4, To: 4}, &result.Replacement{
+			NewLines: []string{"\tx := 1"},
+		}),
+		newNoopFixesTestIssue(path, result.Range{From: 3, To: 6}, &result.Replacement{
+			NewLines: []string{"func foo() {", "\tx := 1", "\t_ = x", "}"},
+		}),
+		newNoopFixesTestIssue(path, result.Range{From: 4, To: 4}, &result.Replacement{
+			Inline: &result.InlineFix{StartCol: 1, Length: 1, NewString: "x"},
+		}),
+	)
+
+	processAssertSame(t, p,
+		newNoopFixesTestIssue(path, result.Range{From: 4, To: 4}, nil),
+		newNoopFixesTestIssue(path, result.Range{From: 3, To: 6}, &result.Replacement{
+			NewLines: []string{"func foo() {", "\ty := 1", "\t_ = y", "}"},
+		}),
+		newNoopFixesTestIssue(path, result.Range{From: 4, To: 5}, &result.Replacement{
+			NewLines: []string{"\tx := 1"},
+		}),
+		newNoopFixesTestIssue(path, result.Range{From: 4, To: 4}, &result.Replacement{
+			Inline: &result.InlineFix{StartCol: 1, Length: 1, NewString: "y"},
+		}),
+		newNoopFixesTestIssue(path, result.Range{From: 4, To: 4}, &result.Replacement{
+			NeedOnlyDelete: true,
+		}),
+	)
+}
+
+func TestNoopFixesDisabled(t *testing.T) {
+	p := NewNoopFixes(false, nil, nil)
+
+	processAssertSame(t, p, newNoopFixesTestIssue("foo.go", result.Range{From: 4, To: 4}, &result.Replacement{
+		NewLines: []string{"\tx := 1"},
+	}))
+}
@@ -0,0 +1,161 @@
+package processors
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// The modes of NormalizePathCase.
+const (
+	NormalizePathCaseAuto   = "auto"   // only on case-insensitive filesystems, detected from the working directory
+	NormalizePathCaseAlways = "always" // even on case-sensitive filesystems
+	NormalizePathCaseNever  = "never"
+)
+
+// NormalizePathCase replaces the paths of the issues by the paths with the case of the files on disk:
+// on a case-insensitive filesystem, the same file can be reported with different cases,
+// the issues of such paths are then deduplicated like the issues of the same path.
+type NormalizePathCase struct {
+	enabled bool
+
+	canonicalPaths map[string]string   // path -> path with the case on disk
+	dirEntries     map[string][]string // directory -> names of its entries
+}
+
+var _ Processor = &NormalizePathCase{}
+
+func NewNormalizePathCase(mode string) (*NormalizePathCase, error) {
+	p := &NormalizePathCase{
+		canonicalPaths: map[string]string{},
+		dirEntries:     map[string][]string{},
+	}
+
+	switch mode {
+	case NormalizePathCaseAuto, "":
+		p.enabled = isCaseInsensitiveFS()
+	case NormalizePathCaseAlways:
+		p.enabled = true
+	case NormalizePathCaseNever:
+	default:
+		return nil, fmt.Errorf("unknown mode %q: expected %s, %s or %s",
+			mode, NormalizePathCaseAuto, NormalizePathCaseAlways, NormalizePathCaseNever)
+	}
+
+	return p, nil
+}
+
+func (p NormalizePathCase) Name() string {
+	return "normalize_path_case"
+}
+
+func (p *NormalizePathCase) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		path := p.canonicalPath(i.FilePath())
+		if path == i.FilePath() {
+			return i
+		}
+
+		newI := *i
+		newI.Pos.Filename = path
+		return &newI
+	}), nil
+}
+
+func (p NormalizePathCase) Finish() {}
+
+// canonicalPath returns the path with the case of its elements on disk,
+// the elements which aren't found are unchanged.
+func (p *NormalizePathCase) canonicalPath(path string) string {
+	path = filepath.Clean(path)
+	if canonical, ok := p.canonicalPaths[path]; ok {
+		return canonical
+	}
+
+	canonical := path
+
+	dir, base := filepath.Split(path)
+	if base != "" && base != "." && base != ".." {
+		var parent string
+		if dir != "" {
+			parent = p.canonicalPath(dir)
+		}
+
+		canonical = filepath.Join(parent, p.canonicalName(parent, base))
+	}
+
+	p.canonicalPaths[path] = canonical
+	return canonical
+}
+
+// canonicalName returns the name of the entry of the directory matching the name case-insensitively:
+// the name itself if it's an entry.
+func (p *NormalizePathCase) canonicalName(dir, name string) string {
+	if dir == "" {
+		dir = "."
+	}
+
+	entries, ok := p.dirEntries[dir]
+	if !ok {
+		// the entries of a directory are read once for all its files.
+		dirEntries, err := os.ReadDir(dir)
+		if err == nil {
+			for _, entry := range dirEntries {
+				entries = append(entries, entry.Name())
+			}
+		}
+		p.dirEntries[dir] = entries
+	}
+
+	canonical := name
+	for _, entry := range entries {
+		if entry == name {
+			return name
+		}
+
+		if canonical == name && strings.EqualFold(entry, name) {
+			canonical = entry
+		}
+	}
+
+	return canonical
+}
+
+// isCaseInsensitiveFS checks whether the filesystem of the working directory is case-insensitive:
+// the working directory is found with another case.
+func isCaseInsensitiveFS() bool {
+	wd, err := os.Getwd()
+	if err != nil {
+		return false
+	}
+
+	swapped := strings.ToUpper(wd)
+	if swapped == wd {
+		swapped = strings.ToLower(wd)
+	}
+
+	if swapped == wd {
+		// no letters: the default filesystems of these systems are case-insensitive.
+		return runtime.GOOS == "darwin" || runtime.GOOS == "windows"
+	}
+
+	wdInfo, err := os.Stat(wd)
+	if err != nil {
+		return false
+	}
+
+	swappedInfo, err := os.Stat(swapped)
+	if err != nil {
+		return false
+	}
+
+	return os.SameFile(wdInfo, swappedInfo)
+}
@@ -0,0 +1,41 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePathCase(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "Pkg"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Pkg", "File.go"), []byte("package pkg\n"), 0o600))
+
+	p, err := NewNormalizePathCase(NormalizePathCaseAlways)
+	require.NoError(t, err)
+
+	miscased := newFLIssue(filepath.Join(dir, "pkg", "file.go"), 10)
+	canonical := newFLIssue(filepath.Join(dir, "Pkg", "File.go"), 10)
+	missing := newFLIssue(filepath.Join(dir, "Pkg", "missing.go"), 10)
+
+	processedIssues := process(t, p, miscased, canonical, missing)
+	require.Len(t, processedIssues, 3)
+	assert.Equal(t, canonical, processedIssues[0])
+	assert.Equal(t, canonical, processedIssues[1])
+	assert.Equal(t, missing, processedIssues[2])
+}
+
+func TestNormalizePathCaseNever(t *testing.T) {
+	p, err := NewNormalizePathCase(NormalizePathCaseNever)
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newFLIssue(filepath.Join("PKG", "file.go"), 10))
+}
+
+func TestNormalizePathCaseInvalidMode(t *testing.T) {
+	_, err := NewNormalizePathCase("sometimes")
+	require.Error(t, err)
+}
@@ -0,0 +1,129 @@
+package processors
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// OnlyTrackedFiles drops the issues of the files not tracked by git, e.g. the untracked scratch files.
+// The tracked files are listed once, by `git ls-files`: out of a git repository, no issue is dropped.
+type OnlyTrackedFiles struct {
+	enabled bool
+	log     logutils.Log
+
+	// listTrackedFiles returns the absolute paths of the tracked files, nil if there is no repository.
+	listTrackedFiles func() ([]string, error)
+
+	trackedFiles map[string]bool   // the real paths, nil until listed
+	realPaths    map[string]string // the real paths of the paths of the issues
+	noRepository bool
+
+	// skippedFiles are counted once per file: the processor also scopes the files of the linters (see lint.Runner).
+	skippedFiles map[string]bool
+}
+
+var _ Processor = (*OnlyTrackedFiles)(nil)
+
+func NewOnlyTrackedFiles(enabled bool, log logutils.Log) *OnlyTrackedFiles {
+	return &OnlyTrackedFiles{
+		enabled:          enabled,
+		log:              log,
+		listTrackedFiles: gitTrackedFiles,
+		realPaths:        map[string]string{},
+		skippedFiles:     map[string]bool{},
+	}
+}
+
+func (p *OnlyTrackedFiles) Name() string {
+	return "only_tracked_files"
+}
+
+func (p *OnlyTrackedFiles) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled || p.noRepository {
+		return issues, nil
+	}
+
+	if p.trackedFiles == nil {
+		files, err := p.listTrackedFiles()
+		if err != nil {
+			return nil, err
+		}
+
+		if files == nil {
+			p.log.Infof("Not in a git repository: the issues of all the files are reported")
+			p.noRepository = true
+			return issues, nil
+		}
+
+		p.trackedFiles = make(map[string]bool, len(files))
+		for _, file := range files {
+			p.trackedFiles[evalSymlinks(file)] = true
+		}
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		path, ok := p.realPaths[i.FilePath()]
+		if !ok {
+			absPath, err := filepath.Abs(i.FilePath())
+			if err != nil {
+				p.log.Warnf("Can't abs-ify path %q: %s", i.FilePath(), err)
+				return true
+			}
+
+			path = evalSymlinks(absPath)
+			p.realPaths[i.FilePath()] = path
+		}
+
+		if !p.trackedFiles[path] {
+			p.skippedFiles[path] = true
+			return false
+		}
+
+		return true
+	}), nil
+}
+
+func (p *OnlyTrackedFiles) Finish() {
+	if len(p.skippedFiles) != 0 {
+		p.log.Infof("Skipped the issues of %d files not tracked by git", len(p.skippedFiles))
+	}
+}
+
+// evalSymlinks returns the path without symlinks, e.g. a repository in a symlinked directory,
+// the path itself if it can't be resolved, e.g. a tracked file removed from the working tree.
+func evalSymlinks(path string) string {
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return path
+	}
+
+	return realPath
+}
+
+func gitTrackedFiles() ([]string, error) {
+	topLevel, err := exec.Command("git", "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		// not in a git repository (or no git): nothing to filter.
+		return nil, nil
+	}
+	root := string(bytes.TrimSpace(topLevel))
+
+	out, err := exec.Command("git", "-C", root, "ls-files", "-z").Output()
+	if err != nil {
+		return nil, fmt.Errorf("error executing git ls-files: %w", err)
+	}
+
+	files := []string{}
+	for _, file := range bytes.Split(out, []byte{0}) {
+		if len(file) != 0 {
+			files = append(files, filepath.Join(root, filepath.FromSlash(string(file))))
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,66 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestOnlyTrackedFiles(t *testing.T) {
+	tracked, err := filepath.Abs("tracked.go")
+	require.NoError(t, err)
+
+	p := NewOnlyTrackedFiles(true, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	p.listTrackedFiles = func() ([]string, error) {
+		return []string{tracked}, nil
+	}
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "tracked.go", Line: 1, Linter: "govet"}))
+	processAssertEmpty(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "scratch.go", Line: 1, Linter: "govet"}))
+}
+
+func TestOnlyTrackedFilesSymlinks(t *testing.T) {
+	dir := t.TempDir()
+
+	repo := filepath.Join(dir, "repo")
+	require.NoError(t, os.Mkdir(repo, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "tracked.go"), []byte("package repo\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "scratch.go"), []byte("package repo\n"), 0o600))
+
+	link := filepath.Join(dir, "link")
+	if err := os.Symlink(repo, link); err != nil {
+		t.Skipf("can't create a symlink: %s", err)
+	}
+
+	p := NewOnlyTrackedFiles(true, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	p.listTrackedFiles = func() ([]string, error) {
+		return []string{filepath.Join(link, "tracked.go")}, nil
+	}
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join(repo, "tracked.go"), Line: 1, Linter: "govet"}))
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join(link, "tracked.go"), Line: 1, Linter: "govet"}))
+	processAssertEmpty(t, p, newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join(link, "scratch.go"), Line: 1, Linter: "govet"}))
+	processAssertEmpty(t, p, newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join(repo, "scratch.go"), Line: 2, Linter: "govet"}))
+
+	// the issues of a file are counted once, e.g. processed again to scope the files of a linter.
+	require.Len(t, p.skippedFiles, 1)
+}
+
+func TestOnlyTrackedFilesNoRepository(t *testing.T) {
+	p := NewOnlyTrackedFiles(true, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	p.listTrackedFiles = func() ([]string, error) {
+		return nil, nil
+	}
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "scratch.go", Line: 1, Linter: "govet"}))
+}
+
+func TestOnlyTrackedFilesDisabled(t *testing.T) {
+	p := NewOnlyTrackedFiles(false, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "scratch.go", Line: 1, Linter: "govet"}))
+}
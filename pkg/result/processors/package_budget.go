@@ -0,0 +1,122 @@
+package processors
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// defaultLinterWeight is the weight of the issues of the linters without a configured weight.
+const defaultLinterWeight = 1
+
+// PackageBudget caps the weighted sum of the issues of each package:
+// each issue weighs the weight of its linter, and the excess issues are dropped,
+// the lowest weights first, ties broken by position (the last ones first).
+type PackageBudget struct {
+	budget  int
+	weights map[string]int
+	log     logutils.Log
+
+	hiddenPackages map[string]int
+}
+
+var _ Processor = &PackageBudget{}
+
+func NewPackageBudget(budget int, weights map[string]int, log logutils.Log) *PackageBudget {
+	return &PackageBudget{
+		budget:         budget,
+		weights:        weights,
+		log:            log,
+		hiddenPackages: map[string]int{},
+	}
+}
+
+func (p PackageBudget) Name() string {
+	return "package_budget"
+}
+
+func (p *PackageBudget) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.budget <= 0 { // disabled
+		return issues, nil
+	}
+
+	byPackage := map[string][]*result.Issue{}
+	for i := range issues {
+		pkg := issuePackage(&issues[i])
+		byPackage[pkg] = append(byPackage[pkg], &issues[i])
+	}
+
+	dropped := map[*result.Issue]bool{}
+	for pkg, pkgIssues := range byPackage {
+		total := 0
+		for _, issue := range pkgIssues {
+			total += p.weight(issue)
+		}
+
+		if total <= p.budget {
+			continue
+		}
+
+		// the highest priority first: the issues to drop are at the end.
+		sort.SliceStable(pkgIssues, func(i, j int) bool {
+			wi, wj := p.weight(pkgIssues[i]), p.weight(pkgIssues[j])
+			if wi != wj {
+				return wi > wj
+			}
+			return isBeforeInFile(pkgIssues[i], pkgIssues[j])
+		})
+
+		for ind := len(pkgIssues) - 1; ind >= 0 && total > p.budget; ind-- {
+			dropped[pkgIssues[ind]] = true
+			total -= p.weight(pkgIssues[ind])
+			p.hiddenPackages[pkg]++
+		}
+	}
+
+	if len(dropped) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		return !dropped[i]
+	}), nil
+}
+
+func (p PackageBudget) Finish() {
+	if hidden := countHiddenIssues(p.hiddenPackages, 0); hidden > 0 {
+		p.log.Warnf("Hid %d issues from %d packages due to package-budget (%d): set it to 0 to show all issues",
+			hidden, len(p.hiddenPackages), p.budget)
+	}
+
+	walkStringToIntMapSortedByValue(p.hiddenPackages, func(pkg string, count int) {
+		p.log.Infof("%d issues of package %s were hidden, use --package-budget", count, pkg)
+	})
+}
+
+func (p PackageBudget) weight(issue *result.Issue) int {
+	if w, ok := p.weights[issue.FromLinter]; ok {
+		return w
+	}
+	return defaultLinterWeight
+}
+
+// issuePackage returns the import path of the package of the issue,
+// or its directory when the package is unknown.
+func issuePackage(issue *result.Issue) string {
+	if issue.Pkg != nil && issue.Pkg.PkgPath != "" {
+		return issue.Pkg.PkgPath
+	}
+	return filepath.Dir(issue.FilePath())
+}
+
+func isBeforeInFile(a, b *result.Issue) bool {
+	if a.FilePath() != b.FilePath() {
+		return a.FilePath() < b.FilePath()
+	}
+	if a.Line() != b.Line() {
+		return a.Line() < b.Line()
+	}
+	return a.Column() < b.Column()
+}
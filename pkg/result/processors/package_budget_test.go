@@ -0,0 +1,50 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestPackageBudget(t *testing.T) {
+	p := NewPackageBudget(6, map[string]int{"gosec": 3, "lll": 1}, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	gosec1 := newIssueFromIssueTestCase(issueTestCase{Path: "a/a.go", Linter: "gosec", Line: 1})
+	gosec2 := newIssueFromIssueTestCase(issueTestCase{Path: "a/b.go", Linter: "gosec", Line: 7})
+	lll1 := newIssueFromIssueTestCase(issueTestCase{Path: "a/a.go", Linter: "lll", Line: 2})
+	lll2 := newIssueFromIssueTestCase(issueTestCase{Path: "a/a.go", Linter: "lll", Line: 5})
+	other := newIssueFromIssueTestCase(issueTestCase{Path: "b/b.go", Linter: "lll", Line: 1})
+
+	// package a weighs 3+1+3+1 = 8 > 6: both lll issues are dropped, the last one first.
+	processed := process(t, p, gosec1, lll1, gosec2, lll2, other)
+	assert.Equal(t, []result.Issue{gosec1, gosec2, other}, processed)
+}
+
+func TestPackageBudgetTies(t *testing.T) {
+	p := NewPackageBudget(2, nil, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	pkg := &packages.Package{PkgPath: "example.com/pkg"}
+
+	first := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Linter: "govet", Line: 3})
+	first.Pkg = pkg
+	second := newIssueFromIssueTestCase(issueTestCase{Path: "b.go", Linter: "errcheck", Line: 1})
+	second.Pkg = pkg
+	third := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Linter: "errcheck", Line: 10})
+	third.Pkg = pkg
+
+	// same weight: the last issues of the package are dropped.
+	processed := process(t, p, second, third, first)
+	assert.Equal(t, []result.Issue{third, first}, processed)
+}
+
+func TestPackageBudgetDisabled(t *testing.T) {
+	p := NewPackageBudget(0, nil, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p,
+		newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Linter: "govet", Line: 1}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Linter: "govet", Line: 2}))
+}
@@ -0,0 +1,33 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// PackagePath sets the import path of the package of each issue.
+// Issues without a package get an empty path.
+type PackagePath struct{}
+
+var _ Processor = PackagePath{}
+
+func NewPackagePath() *PackagePath {
+	return &PackagePath{}
+}
+
+func (p PackagePath) Name() string {
+	return "package_path"
+}
+
+func (p PackagePath) Process(issues []result.Issue) ([]result.Issue, error) {
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		if i.Pkg == nil || i.Pkg.PkgPath == "" {
+			return i
+		}
+
+		newI := *i
+		newI.PackagePath = i.Pkg.PkgPath
+		return &newI
+	}), nil
+}
+
+func (p PackagePath) Finish() {}
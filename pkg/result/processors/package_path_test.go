@@ -0,0 +1,26 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestPackagePath(t *testing.T) {
+	p := NewPackagePath()
+
+	withPkg := result.Issue{Text: "with package", Pkg: &packages.Package{PkgPath: "github.com/org/repo/pkg"}}
+	withoutPkg := result.Issue{Text: "without package"}
+
+	processed := process(t, p, withPkg, withoutPkg)
+	require.Len(t, processed, 2)
+	assert.Equal(t, "github.com/org/repo/pkg", processed[0].PackagePath)
+	assert.Empty(t, processed[1].PackagePath)
+
+	// the input issues aren't modified
+	assert.Empty(t, withPkg.PackagePath)
+}
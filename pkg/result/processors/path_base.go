@@ -0,0 +1,72 @@
+package processors
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// PathBase rewrites the paths of the issues relative to a base directory, instead of the current directory.
+// The paths which can't be relative to the base (e.g. on another drive on Windows) are kept absolute.
+type PathBase struct {
+	base string // absolute
+	log  logutils.Log
+
+	warned map[string]bool // the paths kept absolute
+}
+
+var _ Processor = (*PathBase)(nil)
+
+func NewPathBase(base string, log logutils.Log) (*PathBase, error) {
+	p := &PathBase{log: log, warned: map[string]bool{}}
+	if base == "" {
+		return p, nil
+	}
+
+	absBase, err := filepath.Abs(base)
+	if err != nil {
+		return nil, fmt.Errorf("can't get the absolute path of the path base %q: %w", base, err)
+	}
+	p.base = absBase
+
+	return p, nil
+}
+
+func (p *PathBase) Name() string {
+	return "path_base"
+}
+
+// Process rewrites each path relative to the base.
+// The input issues aren't modified: processing them again doesn't rewrite the paths twice.
+func (p *PathBase) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.base == "" {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		absPath, err := filepath.Abs(i.FilePath())
+		if err != nil {
+			p.log.Warnf("Can't get the absolute path of %q: %s", i.FilePath(), err)
+			return i
+		}
+
+		newI := *i
+		newI.Pos.Filename = absPath
+
+		relPath, err := filepath.Rel(p.base, absPath)
+		if err != nil {
+			if !p.warned[absPath] {
+				p.warned[absPath] = true
+				p.log.Warnf("Can't make %s relative to the path base %s, it's kept absolute: %s", absPath, p.base, err)
+			}
+			return &newI
+		}
+
+		newI.Pos.Filename = relPath
+		return &newI
+	}), nil
+}
+
+func (*PathBase) Finish() {}
@@ -0,0 +1,42 @@
+package processors
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestPathBase(t *testing.T) {
+	p, err := NewPathBase("..", logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	wd, err := filepath.Abs(".")
+	require.NoError(t, err)
+	dirName := filepath.Base(wd)
+
+	issue := newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join("sub", "a.go"), Line: 1, Linter: "govet"})
+	absIssue := newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join(wd, "b.go"), Line: 1, Linter: "govet"})
+
+	processed := process(t, p, issue, absIssue)
+
+	expected := []result.Issue{
+		newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join(dirName, "sub", "a.go"), Line: 1, Linter: "govet"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join(dirName, "b.go"), Line: 1, Linter: "govet"}),
+	}
+	assert.Equal(t, expected, processed)
+
+	// the input issues aren't modified.
+	assert.Equal(t, filepath.Join("sub", "a.go"), issue.FilePath())
+}
+
+func TestPathBaseDisabled(t *testing.T) {
+	p, err := NewPathBase("", logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "govet"}))
+}
@@ -0,0 +1,50 @@
+package processors
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// PathGlobFilter keeps only the issues in the paths matching one of the globs,
+// e.g. to compute the exit code on the issues of some directories only.
+// The globs match the slash-separated paths of the output: `**/` matches zero or more directories,
+// `*` and `?` don't match a `/`.
+type PathGlobFilter struct {
+	globs []*regexp.Regexp
+}
+
+var _ Processor = PathGlobFilter{}
+
+// NewPathGlobFilter returns a processor keeping the issues in the paths matching the globs.
+// No globs means no filtering.
+func NewPathGlobFilter(globs []string) *PathGlobFilter {
+	return &PathGlobFilter{globs: compileGlobs(globs)}
+}
+
+func (p PathGlobFilter) Name() string {
+	return "path_glob_filter"
+}
+
+func (p PathGlobFilter) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.globs) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, p.matches), nil
+}
+
+// matches reports whether the path of the issue matches one of the globs.
+func (p PathGlobFilter) matches(i *result.Issue) bool {
+	path := filepath.ToSlash(i.FilePath())
+	for _, glob := range p.globs {
+		if glob.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p PathGlobFilter) Finish() {}
@@ -0,0 +1,26 @@
+package processors
+
+import (
+	"testing"
+)
+
+func TestPathGlobFilter(t *testing.T) {
+	p := NewPathGlobFilter([]string{"newcode/**", "**/api/*.go"})
+
+	processAssertSame(t, p,
+		newIssueFromIssueTestCase(issueTestCase{Path: "newcode/a.go", Line: 1}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "newcode/sub/b.go", Line: 2}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "api/c.go", Line: 3}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "pkg/api/d.go", Line: 4}))
+
+	processAssertEmpty(t, p,
+		newIssueFromIssueTestCase(issueTestCase{Path: "oldcode/a.go", Line: 1}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "pkg/newcode/a.go", Line: 2}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "pkg/api/sub/e.go", Line: 3}))
+}
+
+func TestPathGlobFilterNoGlobs(t *testing.T) {
+	p := NewPathGlobFilter(nil)
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "oldcode/a.go", Line: 1}))
+}
@@ -23,14 +23,18 @@ func (*PathPrefixer) Name() string {
 	return "path_prefixer"
 }
 
-// Process adds the prefix to each path
+// Process adds the prefix to each path.
+// The input issues aren't modified: processing them again doesn't apply the prefix twice.
 func (p *PathPrefixer) Process(issues []result.Issue) ([]result.Issue, error) {
-	if p.prefix != "" {
-		for i := range issues {
-			issues[i].Pos.Filename = filepath.Join(p.prefix, issues[i].Pos.Filename)
-		}
+	if p.prefix == "" {
+		return issues, nil
 	}
-	return issues, nil
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		newI := *i
+		newI.Pos.Filename = filepath.Join(p.prefix, i.Pos.Filename)
+		return &newI
+	}), nil
 }
 
 // Finish is implemented to satisfy the Processor interface
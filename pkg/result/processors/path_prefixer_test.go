@@ -51,3 +51,18 @@ func TestPathPrefixer_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestPathPrefixer_ProcessTwice(t *testing.T) {
+	issues := []result.Issue{{Pos: token.Position{Filename: filepath.FromSlash("some/path")}}}
+
+	p := NewPathPrefixer("ok")
+
+	_, err := p.Process(issues)
+	require.NoError(t, err)
+
+	got, err := p.Process(issues)
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.FromSlash("ok/some/path"), got[0].Pos.Filename)
+	assert.Equal(t, filepath.FromSlash("some/path"), issues[0].Pos.Filename)
+}
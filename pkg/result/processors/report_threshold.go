@@ -0,0 +1,46 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// ReportThreshold is a noise gate: it reports all the issues if there are more than the threshold, none otherwise.
+type ReportThreshold struct {
+	threshold int
+	log       logutils.Log
+
+	hiddenCount int
+}
+
+var _ Processor = &ReportThreshold{}
+
+func NewReportThreshold(threshold int, log logutils.Log) *ReportThreshold {
+	return &ReportThreshold{
+		threshold: threshold,
+		log:       log,
+	}
+}
+
+func (p ReportThreshold) Name() string {
+	return "report_threshold"
+}
+
+func (p *ReportThreshold) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.threshold <= 0 { // always report
+		return issues, nil
+	}
+
+	if len(issues) > p.threshold {
+		return issues, nil
+	}
+
+	p.hiddenCount += len(issues)
+	return []result.Issue{}, nil
+}
+
+func (p ReportThreshold) Finish() {
+	if p.hiddenCount > 0 {
+		p.log.Infof("Hid %d issues: not more than the report threshold (%d), use --report-threshold", p.hiddenCount, p.threshold)
+	}
+}
@@ -0,0 +1,35 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestReportThreshold(t *testing.T) {
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+
+	issues := []issueTestCase{
+		{Path: "a.go", Line: 1, Linter: "govet"},
+		{Path: "a.go", Line: 2, Linter: "govet"},
+		{Path: "b.go", Line: 1, Linter: "errcheck"},
+	}
+
+	p := NewReportThreshold(3, log)
+	processAssertEmpty(t, p,
+		newIssueFromIssueTestCase(issues[0]),
+		newIssueFromIssueTestCase(issues[1]),
+		newIssueFromIssueTestCase(issues[2]))
+
+	p = NewReportThreshold(2, log)
+	processAssertSame(t, p,
+		newIssueFromIssueTestCase(issues[0]),
+		newIssueFromIssueTestCase(issues[1]),
+		newIssueFromIssueTestCase(issues[2]))
+}
+
+func TestReportThresholdDisabled(t *testing.T) {
+	p := NewReportThreshold(0, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "govet"}))
+}
@@ -0,0 +1,112 @@
+package processors
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// rollupVariableRe matches the parts of the texts varying between the issues of the same check:
+// the quoted parts (identifiers, values) and the numbers.
+var rollupVariableRe = regexp.MustCompile("`[^`]*`|\"[^\"]*\"|'[^']*'|\\d+")
+
+// RollupConsecutive collapses the runs of issues of the same linter, with the same normalized text,
+// on consecutive lines of a file (N, N+1, ...) into one issue ranging from the first line to the last one.
+// The issues with a replacement, or with an end position, aren't rolled up: their fix or range would be lost.
+type RollupConsecutive struct {
+	enabled bool
+}
+
+var _ Processor = RollupConsecutive{}
+
+func NewRollupConsecutive(enabled bool) *RollupConsecutive {
+	return &RollupConsecutive{enabled: enabled}
+}
+
+func (p RollupConsecutive) Name() string {
+	return "rollup_consecutive"
+}
+
+func (p RollupConsecutive) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	type runKey struct {
+		linter, file, text string
+	}
+
+	candidates := map[runKey][]*result.Issue{}
+	for ind := range issues {
+		i := &issues[ind]
+		if i.Replacement != nil || i.EndPos != nil {
+			continue
+		}
+
+		key := runKey{linter: i.FromLinter, file: i.FilePath(), text: normalizeRollupText(i.Text)}
+		candidates[key] = append(candidates[key], i)
+	}
+
+	runs := map[*result.Issue][]*result.Issue{} // first issue of a run -> the issues of the run
+	merged := map[*result.Issue]bool{}          // the issues merged into the first one of their run
+	for _, runIssues := range candidates {
+		if len(runIssues) < 2 {
+			continue
+		}
+
+		sort.SliceStable(runIssues, func(i, j int) bool {
+			return isBeforeInFile(runIssues[i], runIssues[j])
+		})
+
+		start := 0
+		for ind := 1; ind <= len(runIssues); ind++ {
+			if ind < len(runIssues) && runIssues[ind].Line() <= runIssues[ind-1].GetLineRange().To+1 {
+				continue
+			}
+
+			if ind-start > 1 {
+				runs[runIssues[start]] = runIssues[start:ind]
+				for _, i := range runIssues[start+1 : ind] {
+					merged[i] = true
+				}
+			}
+			start = ind
+		}
+	}
+
+	if len(merged) == 0 {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		if merged[i] {
+			return nil
+		}
+
+		run := runs[i]
+		if run == nil {
+			return i
+		}
+
+		last := run[len(run)-1]
+		to := last.GetLineRange().To
+
+		newI := *i
+		newI.Text = fmt.Sprintf("%s (%d issues on lines %d-%d)", i.Text, len(run), i.Line(), to)
+		newI.LineRange = &result.Range{From: i.Line(), To: to}
+		newI.EndPos = &result.EndPosition{Line: last.Line(), Column: last.Column()}
+
+		return &newI
+	}), nil
+}
+
+func (p RollupConsecutive) Finish() {}
+
+// normalizeRollupText returns the text of an issue without the parts specific to the issue,
+// e.g. "exported function `Foo` should have comment" and "exported function `Bar` should have comment"
+// have the same normalized text.
+func normalizeRollupText(text string) string {
+	return normalizeDiagnosticMessage(rollupVariableRe.ReplaceAllString(text, "_"))
+}
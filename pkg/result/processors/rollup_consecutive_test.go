@@ -0,0 +1,47 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestRollupConsecutive(t *testing.T) {
+	p := NewRollupConsecutive(true)
+
+	first := newRangeIssue("revive", "a.go", "exported const `A` should have comment", 3, 1)
+	second := newRangeIssue("revive", "a.go", "exported const `B` should have comment", 4, 1)
+	third := newRangeIssue("revive", "a.go", "exported const `C` should have comment", 5, 7)
+	apart := newRangeIssue("revive", "a.go", "exported const `D` should have comment", 9, 1)
+	otherLinter := newRangeIssue("stylecheck", "a.go", "exported const `B` should have comment", 4, 1)
+	otherFile := newRangeIssue("revive", "b.go", "exported const `E` should have comment", 4, 1)
+	otherText := newRangeIssue("revive", "a.go", "unused parameter `x`", 6, 1)
+
+	processed := process(t, p, third, otherLinter, first, apart, otherFile, second, otherText)
+
+	rolledUp := first
+	rolledUp.Text = "exported const `A` should have comment (3 issues on lines 3-5)"
+	rolledUp.LineRange = &result.Range{From: 3, To: 5}
+	rolledUp.EndPos = &result.EndPosition{Line: 5, Column: 7}
+
+	assert.Equal(t, []result.Issue{otherLinter, rolledUp, apart, otherFile, otherText}, processed)
+}
+
+func TestRollupConsecutiveWithReplacement(t *testing.T) {
+	p := NewRollupConsecutive(true)
+
+	fixable := newRangeIssue("gofmt", "a.go", "File is not `gofmt`-ed", 3, 1)
+	fixable.Replacement = &result.Replacement{NewLines: []string{"a := 1"}}
+
+	processAssertSame(t, p, fixable, newRangeIssue("gofmt", "a.go", "File is not `gofmt`-ed", 4, 1))
+}
+
+func TestRollupConsecutiveDisabled(t *testing.T) {
+	p := NewRollupConsecutive(false)
+
+	processAssertSame(t, p,
+		newRangeIssue("revive", "a.go", "exported const `A` should have comment", 3, 1),
+		newRangeIssue("revive", "a.go", "exported const `B` should have comment", 4, 1))
+}
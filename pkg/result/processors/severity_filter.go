@@ -0,0 +1,43 @@
+package processors
+
+import (
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// SeverityFilter keeps only the issues of some severities, e.g. to print them to a dedicated output.
+// It must run after the severity rules set the severities.
+type SeverityFilter struct {
+	severities []string
+}
+
+var _ Processor = SeverityFilter{}
+
+// NewSeverityFilter returns a processor keeping the issues of these severities (case-insensitive).
+// No severities means no filtering.
+func NewSeverityFilter(severities []string) *SeverityFilter {
+	return &SeverityFilter{severities: severities}
+}
+
+func (p SeverityFilter) Name() string {
+	return "severity_filter"
+}
+
+func (p SeverityFilter) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.severities) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		for _, severity := range p.severities {
+			if strings.EqualFold(i.Severity, severity) {
+				return true
+			}
+		}
+
+		return false
+	}), nil
+}
+
+func (p SeverityFilter) Finish() {}
@@ -0,0 +1,25 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestSeverityFilter(t *testing.T) {
+	p := NewSeverityFilter([]string{"error", "Warning"})
+
+	errorIssue := result.Issue{Text: "a", Severity: "error"}
+	warningIssue := result.Issue{Text: "b", Severity: "warning"}
+	infoIssue := result.Issue{Text: "c", Severity: "info"}
+	noSeverityIssue := result.Issue{Text: "d"}
+
+	processAssertSame(t, p, errorIssue, warningIssue)
+	processAssertEmpty(t, p, infoIssue, noSeverityIssue)
+}
+
+func TestSeverityFilterDisabled(t *testing.T) {
+	p := NewSeverityFilter(nil)
+
+	processAssertSame(t, p, result.Issue{Text: "a", Severity: "info"}, result.Issue{Text: "b"})
+}
@@ -1,16 +1,25 @@
 package processors
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
+// The severity reasons of the issues without matching severity rule.
+const (
+	SeverityReasonDefault          = "default"
+	SeverityReasonDefaultPerLinter = "default-per-linter"
+)
+
 type severityRule struct {
 	baseRule
 	severity string
+	reason   string // the reason of the severities set by the rule, e.g. `rule #1 [path=_test\.go]`
 }
 
 type SeverityRule struct {
@@ -18,18 +27,44 @@ type SeverityRule struct {
 	Severity string
 }
 
+// describe returns the conditions of the rule, e.g. `path=_test\.go linters=gosec`.
+func (r *SeverityRule) describe() string {
+	var parts []string
+	if r.Path != "" {
+		parts = append(parts, fmt.Sprintf("path=%s", r.Path))
+	}
+	if len(r.Linters) != 0 {
+		parts = append(parts, fmt.Sprintf("linters=%s", strings.Join(r.Linters, ",")))
+	}
+	if r.Text != "" {
+		parts = append(parts, fmt.Sprintf("text=%s", r.Text))
+	}
+	if r.Source != "" {
+		parts = append(parts, fmt.Sprintf("source=%s", r.Source))
+	}
+
+	return strings.Join(parts, " ")
+}
+
 type SeverityRules struct {
 	defaultSeverity string
+	linterDefaults  map[string]string // linter name -> default severity of its issues
 	rules           []severityRule
 	lineCache       *fsutils.LineCache
 	log             logutils.Log
 }
 
-func NewSeverityRules(defaultSeverity string, rules []SeverityRule, lineCache *fsutils.LineCache, log logutils.Log) *SeverityRules {
+// NewSeverityRules returns the processor setting the severities of the issues:
+// the severity of the first matching rule, else the default severity of the linter, else the default severity.
+// The origin of the severity is recorded in Issue.SeverityReason: the matching rule (e.g. `rule #1 [path=_test\.go]`),
+// SeverityReasonDefaultPerLinter or SeverityReasonDefault.
+func NewSeverityRules(defaultSeverity string, linterDefaults map[string]string, rules []SeverityRule,
+	lineCache *fsutils.LineCache, log logutils.Log) *SeverityRules {
 	r := &SeverityRules{
 		lineCache:       lineCache,
 		log:             log,
 		defaultSeverity: defaultSeverity,
+		linterDefaults:  linterDefaults,
 	}
 	r.rules = createSeverityRules(rules, "(?i)")
 
@@ -38,10 +73,13 @@ func NewSeverityRules(defaultSeverity string, rules []SeverityRule, lineCache *f
 
 func createSeverityRules(rules []SeverityRule, prefix string) []severityRule {
 	parsedRules := make([]severityRule, 0, len(rules))
-	for _, rule := range rules {
+	for ind, rule := range rules {
+		rule := rule
+
 		parsedRule := severityRule{}
 		parsedRule.linters = rule.Linters
 		parsedRule.severity = rule.Severity
+		parsedRule.reason = fmt.Sprintf("rule #%d [%s]", ind, rule.describe())
 		if rule.Text != "" {
 			parsedRule.text = regexp.MustCompile(prefix + rule.Text)
 		}
@@ -58,28 +96,75 @@ func createSeverityRules(rules []SeverityRule, prefix string) []severityRule {
 }
 
 func (p SeverityRules) Process(issues []result.Issue) ([]result.Issue, error) {
-	if len(p.rules) == 0 && p.defaultSeverity == "" {
-		return issues, nil
-	}
+	// without rules nor defaults, the severities are the ones of the linters: the duplicates are still merged.
+	hasSeverities := len(p.rules) != 0 || p.defaultSeverity != "" || len(p.linterDefaults) != 0
 	return transformIssues(issues, func(i *result.Issue) *result.Issue {
-		for _, rule := range p.rules {
-			rule := rule
+		if hasSeverities {
+			p.setSeverity(i)
+		}
 
-			ruleSeverity := p.defaultSeverity
-			if rule.severity != "" {
-				ruleSeverity = rule.severity
+		// an issue merged by uniq-by-line or a deduplication takes the highest severity of the merged set,
+		// the survivor winning the ties.
+		for ind := range i.Duplicates {
+			d := i.Duplicates[ind]
+			if hasSeverities {
+				p.setSeverity(&d)
 			}
-
-			if rule.match(i, p.lineCache, p.log) {
-				i.Severity = ruleSeverity
-				return i
+			if severityRank(d.Severity) > severityRank(i.Severity) {
+				i.Severity = d.Severity
+				i.SeverityReason = mergedSeverityReason(&d)
 			}
 		}
-		i.Severity = p.defaultSeverity
 		return i
 	}), nil
 }
 
+// mergedSeverityReason returns e.g. `rule #1 [path=_test\.go] (merged from gosec)`.
+func mergedSeverityReason(d *result.Issue) string {
+	if d.SeverityReason == "" { // the severity of the linter
+		return fmt.Sprintf("merged from %s", d.FromLinter)
+	}
+
+	return fmt.Sprintf("%s (merged from %s)", d.SeverityReason, d.FromLinter)
+}
+
+func (p SeverityRules) setSeverity(i *result.Issue) {
+	defaultSeverity, defaultReason := p.defaultSeverity, SeverityReasonDefault
+	if severity, ok := p.linterDefaults[i.FromLinter]; ok {
+		defaultSeverity, defaultReason = severity, SeverityReasonDefaultPerLinter
+	}
+
+	for _, rule := range p.rules {
+		rule := rule
+
+		ruleSeverity := defaultSeverity
+		if rule.severity != "" {
+			ruleSeverity = rule.severity
+		}
+
+		if rule.match(i, p.lineCache, p.log) {
+			i.Severity = ruleSeverity
+			i.SeverityReason = rule.reason
+			return
+		}
+	}
+	i.Severity = defaultSeverity
+	i.SeverityReason = defaultReason
+}
+
+// severityLevels are the known severities, from the lowest.
+var severityLevels = []string{"info", "warning", "error"}
+
+// severityRank orders the severities from severityLevels, the unknown ones ranking the lowest.
+func severityRank(severity string) int {
+	for ind, level := range severityLevels {
+		if level == severity {
+			return ind
+		}
+	}
+	return -1
+}
+
 func (SeverityRules) Name() string { return "severity-rules" }
 func (SeverityRules) Finish()      {}
 
@@ -89,12 +174,13 @@ type SeverityRulesCaseSensitive struct {
 	*SeverityRules
 }
 
-func NewSeverityRulesCaseSensitive(defaultSeverity string, rules []SeverityRule,
+func NewSeverityRulesCaseSensitive(defaultSeverity string, linterDefaults map[string]string, rules []SeverityRule,
 	lineCache *fsutils.LineCache, log logutils.Log) *SeverityRulesCaseSensitive {
 	r := &SeverityRules{
 		lineCache:       lineCache,
 		log:             log,
 		defaultSeverity: defaultSeverity,
+		linterDefaults:  linterDefaults,
 	}
 	r.rules = createSeverityRules(rules, "")
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/logutils"
@@ -15,7 +16,7 @@ import (
 func TestSeverityRulesMultiple(t *testing.T) {
 	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
 	log := report.NewLogWrapper(logutils.NewStderrLog(logutils.DebugKeyEmpty), &report.Data{})
-	p := NewSeverityRules("error", []SeverityRule{
+	p := NewSeverityRules("error", nil, []SeverityRule{
 		{
 			Severity: "info",
 			BaseRule: BaseRule{
@@ -105,7 +106,7 @@ func TestSeverityRulesMultiple(t *testing.T) {
 }
 
 func TestSeverityRulesText(t *testing.T) {
-	p := NewSeverityRules("", []SeverityRule{
+	p := NewSeverityRules("", nil, []SeverityRule{
 		{
 			BaseRule: BaseRule{
 				Text:    "^severity$",
@@ -135,7 +136,7 @@ func TestSeverityRulesText(t *testing.T) {
 func TestSeverityRulesOnlyDefault(t *testing.T) {
 	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
 	log := report.NewLogWrapper(logutils.NewStderrLog(logutils.DebugKeyEmpty), &report.Data{})
-	p := NewSeverityRules("info", []SeverityRule{}, lineCache, log)
+	p := NewSeverityRules("info", nil, []SeverityRule{}, lineCache, log)
 
 	cases := []issueTestCase{
 		{Path: "ssl.go", Text: "ssl", Linter: "gosec"},
@@ -163,13 +164,63 @@ func TestSeverityRulesOnlyDefault(t *testing.T) {
 	assert.Equal(t, expectedCases, resultingCases)
 }
 
+func TestSeverityRulesDefaultPerLinter(t *testing.T) {
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	log := report.NewLogWrapper(logutils.NewStderrLog(logutils.DebugKeyEmpty), &report.Data{})
+	p := NewSeverityRules("info", map[string]string{"gosec": "error", "gofmt": "warning"}, []SeverityRule{
+		{
+			Severity: "info",
+			BaseRule: BaseRule{
+				Text:    "^weak$",
+				Linters: []string{"gosec"},
+			},
+		},
+		{
+			BaseRule: BaseRule{
+				Path: `_test\.go`,
+			},
+		},
+	}, lineCache, log)
+
+	cases := []issueTestCase{
+		{Path: "ssl.go", Text: "ssl", Linter: "gosec"},
+		{Path: "weak.go", Text: "weak", Linter: "gosec"},
+		{Path: "a.go", Text: "fmt", Linter: "gofmt"},
+		{Path: "a_test.go", Text: "fmt", Linter: "gofmt"},
+		{Path: "empty.go", Text: "empty", Linter: "empty"},
+	}
+	var issues []result.Issue
+	for _, c := range cases {
+		issues = append(issues, newIssueFromIssueTestCase(c))
+	}
+	processedIssues := process(t, p, issues...)
+	var resultingCases []issueTestCase
+	for _, i := range processedIssues {
+		resultingCases = append(resultingCases, issueTestCase{
+			Path:     i.FilePath(),
+			Linter:   i.FromLinter,
+			Text:     i.Text,
+			Line:     i.Line(),
+			Severity: i.Severity,
+		})
+	}
+	expectedCases := []issueTestCase{
+		{Path: "ssl.go", Text: "ssl", Linter: "gosec", Severity: "error"},
+		{Path: "weak.go", Text: "weak", Linter: "gosec", Severity: "info"}, // overridden by a rule
+		{Path: "a.go", Text: "fmt", Linter: "gofmt", Severity: "warning"},
+		{Path: "a_test.go", Text: "fmt", Linter: "gofmt", Severity: "warning"}, // the rule has no severity
+		{Path: "empty.go", Text: "empty", Linter: "empty", Severity: "info"},
+	}
+	assert.Equal(t, expectedCases, resultingCases)
+}
+
 func TestSeverityRulesEmpty(t *testing.T) {
-	processAssertSame(t, NewSeverityRules("", nil, nil, nil), newIssueFromTextTestCase("test"))
+	processAssertSame(t, NewSeverityRules("", nil, nil, nil, nil), newIssueFromTextTestCase("test"))
 }
 
 func TestSeverityRulesCaseSensitive(t *testing.T) {
 	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
-	p := NewSeverityRulesCaseSensitive("error", []SeverityRule{
+	p := NewSeverityRulesCaseSensitive("error", nil, []SeverityRule{
 		{
 			Severity: "info",
 			BaseRule: BaseRule{
@@ -202,3 +253,99 @@ func TestSeverityRulesCaseSensitive(t *testing.T) {
 	}
 	assert.Equal(t, expectedCases, resultingCases)
 }
+
+func TestSeverityRulesReason(t *testing.T) {
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	log := report.NewLogWrapper(logutils.NewStderrLog(logutils.DebugKeyEmpty), &report.Data{})
+	p := NewSeverityRules("info", map[string]string{"gofmt": "warning"}, []SeverityRule{
+		{
+			Severity: "error",
+			BaseRule: BaseRule{
+				Text:    "^weak$",
+				Linters: []string{"gosec"},
+			},
+		},
+		{
+			BaseRule: BaseRule{
+				Path: `_test\.go`,
+			},
+		},
+	}, lineCache, log)
+
+	processedIssues := process(t, p,
+		newIssueFromIssueTestCase(issueTestCase{Path: "weak.go", Text: "weak", Linter: "gosec"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "a_test.go", Text: "fmt", Linter: "govet"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Text: "fmt", Linter: "gofmt"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Text: "empty", Linter: "empty"}))
+
+	var reasons []string
+	for _, i := range processedIssues {
+		reasons = append(reasons, i.SeverityReason)
+	}
+
+	expected := []string{
+		"rule #0 [linters=gosec text=^weak$]",
+		`rule #1 [path=_test\.go]`,
+		SeverityReasonDefaultPerLinter,
+		SeverityReasonDefault,
+	}
+	assert.Equal(t, expected, reasons)
+}
+
+func TestSeverityRulesDuplicates(t *testing.T) {
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	log := report.NewLogWrapper(logutils.NewStderrLog(logutils.DebugKeyEmpty), &report.Data{})
+	p := NewSeverityRules("info", map[string]string{"gosec": "error", "revive": "warning"}, nil, lineCache, log)
+
+	withDuplicates := func(i result.Issue, duplicates ...result.Issue) result.Issue {
+		i.Duplicates = duplicates
+		return i
+	}
+
+	processedIssues := process(t, p,
+		// the highest severity of the duplicates wins
+		withDuplicates(
+			newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Text: "x", Linter: "revive"}),
+			newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Text: "y", Linter: "gosec"}),
+			newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Text: "z", Linter: "govet"})),
+		// a lower severity doesn't lower the survivor's
+		withDuplicates(
+			newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Text: "x", Linter: "revive"}),
+			newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Text: "y", Linter: "govet"})),
+		// the survivor wins the ties
+		withDuplicates(
+			newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 3, Text: "x", Linter: "revive"}),
+			newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 3, Text: "y", Linter: "revive"})))
+
+	var severities, reasons []string
+	for _, i := range processedIssues {
+		severities = append(severities, i.Severity)
+		reasons = append(reasons, i.SeverityReason)
+	}
+
+	assert.Equal(t, []string{"error", "warning", "warning"}, severities)
+	assert.Equal(t, []string{
+		SeverityReasonDefaultPerLinter + " (merged from gosec)",
+		SeverityReasonDefaultPerLinter,
+		SeverityReasonDefaultPerLinter,
+	}, reasons)
+	assert.Equal(t, "x", processedIssues[0].Text)
+}
+
+func TestSeverityRulesDuplicatesWithoutSeverities(t *testing.T) {
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	log := report.NewLogWrapper(logutils.NewStderrLog(logutils.DebugKeyEmpty), &report.Data{})
+	p := NewSeverityRules("", nil, nil, lineCache, log)
+
+	// the severities set by the linters are merged too.
+	survivor := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Text: "x", Linter: "revive"})
+	survivor.Severity = "warning"
+	duplicate := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Text: "y", Linter: "gosec"})
+	duplicate.Severity = "error"
+	survivor.Duplicates = []result.Issue{duplicate}
+
+	processedIssues := process(t, p, survivor)
+	require.Len(t, processedIssues, 1)
+	assert.Equal(t, "error", processedIssues[0].Severity)
+	assert.Equal(t, "merged from gosec", processedIssues[0].SeverityReason)
+}
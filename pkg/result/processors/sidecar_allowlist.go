@@ -0,0 +1,148 @@
+package processors
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// SidecarAllowlistExt is the extension of the allowlist file of a source file, e.g. `foo.go.lintallow` for `foo.go`.
+const SidecarAllowlistExt = ".lintallow"
+
+// SidecarAllowlist drops the issues of a file whose text matches a pattern of the allowlist file next to it:
+// one regular expression per line, the blank lines and the lines starting with `#` are ignored.
+// The files without allowlist file aren't affected.
+type SidecarAllowlist struct {
+	reportUnused bool
+	log          logutils.Log
+
+	allowlists map[string][]*allowlistEntry // absolute source file path -> entries
+}
+
+type allowlistEntry struct {
+	path    string // allowlist file path
+	line    int
+	pattern *regexp.Regexp
+	used    bool
+}
+
+var _ Processor = (*SidecarAllowlist)(nil)
+
+// NewSidecarAllowlist loads the allowlist files of the files of the packages.
+func NewSidecarAllowlist(enabled, reportUnused bool, pkgs []*packages.Package, log logutils.Log) (*SidecarAllowlist, error) {
+	p := &SidecarAllowlist{
+		reportUnused: reportUnused,
+		log:          log,
+		allowlists:   map[string][]*allowlistEntry{},
+	}
+
+	if !enabled {
+		return p, nil
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.GoFiles {
+			if _, ok := p.allowlists[file]; ok {
+				continue // e.g. the test variant of the package
+			}
+
+			entries, err := readAllowlist(file + SidecarAllowlistExt)
+			if err != nil {
+				return nil, err
+			}
+
+			p.allowlists[file] = entries
+		}
+	}
+
+	return p, nil
+}
+
+func readAllowlist(path string) ([]*allowlistEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("can't read allowlist file: %w", err)
+	}
+
+	var entries []*allowlistEntry
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		pattern, err := regexp.Compile(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %s:%d: %w", path, lineNumber, err)
+		}
+
+		entries = append(entries, &allowlistEntry{path: path, line: lineNumber, pattern: pattern})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("can't read allowlist file %s: %w", path, err)
+	}
+
+	return entries, nil
+}
+
+func (p SidecarAllowlist) Name() string {
+	return "sidecar_allowlist"
+}
+
+func (p *SidecarAllowlist) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.allowlists) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		path, err := filepath.Abs(i.FilePath())
+		if err != nil {
+			return true
+		}
+
+		for _, entry := range p.allowlists[path] {
+			if entry.pattern.MatchString(i.Text) {
+				entry.used = true
+				return false
+			}
+		}
+
+		return true
+	}), nil
+}
+
+func (p SidecarAllowlist) Finish() {
+	if !p.reportUnused {
+		return
+	}
+
+	files := make([]string, 0, len(p.allowlists))
+	for file := range p.allowlists {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		for _, entry := range p.allowlists[file] {
+			if !entry.used {
+				p.log.Warnf("Unused allowlist entry %s:%d: %s", entry.path, entry.line, entry.pattern.String())
+			}
+		}
+	}
+}
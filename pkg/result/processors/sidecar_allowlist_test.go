@@ -0,0 +1,58 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestSidecarAllowlist(t *testing.T) {
+	dir := t.TempDir()
+	withAllowlist := filepath.Join(dir, "foo.go")
+	withoutAllowlist := filepath.Join(dir, "bar.go")
+
+	allowlist := "# known-safe in this file\n\nG104: Errors unhandled\n^unused parameter\n"
+	require.NoError(t, os.WriteFile(withAllowlist+SidecarAllowlistExt, []byte(allowlist), 0o600))
+
+	pkgs := []*packages.Package{{GoFiles: []string{withAllowlist, withoutAllowlist}}}
+
+	log := logutils.NewMockLog()
+	log.On("Warnf", "Unused allowlist entry %s:%d: %s", withAllowlist+SidecarAllowlistExt, 4, "^unused parameter").Once()
+
+	p, err := NewSidecarAllowlist(true, true, pkgs, log)
+	require.NoError(t, err)
+
+	allowed := newIssueFromIssueTestCase(issueTestCase{Path: withAllowlist, Linter: "gosec", Text: "G104: Errors unhandled."})
+	other := newIssueFromIssueTestCase(issueTestCase{Path: withAllowlist, Linter: "gosec", Text: "G304: Potential file inclusion"})
+	otherFile := newIssueFromIssueTestCase(issueTestCase{Path: withoutAllowlist, Linter: "gosec", Text: "G104: Errors unhandled."})
+
+	processAssertEmpty(t, p, allowed)
+	processAssertSame(t, p, other, otherFile)
+
+	p.Finish()
+	log.AssertExpectations(t)
+}
+
+func TestSidecarAllowlistInvalidPattern(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "foo.go")
+	require.NoError(t, os.WriteFile(file+SidecarAllowlistExt, []byte("valid\ninvalid(\n"), 0o600))
+
+	_, err := NewSidecarAllowlist(true, false, []*packages.Package{{GoFiles: []string{file}}}, logutils.NewMockLog())
+	assert.ErrorContains(t, err, "invalid pattern "+file+SidecarAllowlistExt+":2:")
+}
+
+func TestSidecarAllowlistDisabled(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "foo.go")
+	require.NoError(t, os.WriteFile(file+SidecarAllowlistExt, []byte("issue\n"), 0o600))
+
+	p, err := NewSidecarAllowlist(false, false, []*packages.Package{{GoFiles: []string{file}}}, logutils.NewMockLog())
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: file, Linter: "linter", Text: "issue"}))
+}
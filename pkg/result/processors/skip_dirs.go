@@ -3,7 +3,6 @@ package processors
 import (
 	"path/filepath"
 	"regexp"
-	"strings"
 
 	"github.com/pkg/errors"
 
@@ -23,13 +22,14 @@ type SkipDirs struct {
 	skippedDirs      map[string]*skipStat
 	absArgsDirs      []string
 	skippedDirsCache map[string]bool
+	goFileExtensions []string
 }
 
 var _ Processor = (*SkipDirs)(nil)
 
 const goFileSuffix = ".go"
 
-func NewSkipDirs(patterns []string, log logutils.Log, runArgs []string) (*SkipDirs, error) {
+func NewSkipDirs(patterns []string, log logutils.Log, runArgs, goFileExtensions []string) (*SkipDirs, error) {
 	var patternsRe []*regexp.Regexp
 	for _, p := range patterns {
 		p = fsutils.NormalizePathInRegex(p)
@@ -43,10 +43,12 @@ func NewSkipDirs(patterns []string, log logutils.Log, runArgs []string) (*SkipDi
 	if len(runArgs) == 0 {
 		runArgs = append(runArgs, "./...")
 	}
+	goFileExtensions = normalizeGoFileExtensions(goFileExtensions)
+
 	var absArgsDirs []string
 	for _, arg := range runArgs {
 		base := filepath.Base(arg)
-		if base == "..." || strings.HasSuffix(base, goFileSuffix) {
+		if base == "..." || hasGoFileExtension(base, goFileExtensions) {
 			arg = filepath.Dir(arg)
 		}
 
@@ -63,6 +65,7 @@ func NewSkipDirs(patterns []string, log logutils.Log, runArgs []string) (*SkipDi
 		skippedDirs:      map[string]*skipStat{},
 		absArgsDirs:      absArgsDirs,
 		skippedDirsCache: map[string]bool{},
+		goFileExtensions: goFileExtensions,
 	}, nil
 }
 
@@ -80,7 +83,7 @@ func (p *SkipDirs) Process(issues []result.Issue) ([]result.Issue, error) {
 
 func (p *SkipDirs) shouldPassIssue(i *result.Issue) bool {
 	if filepath.IsAbs(i.FilePath()) {
-		if !isSpecialAutogeneratedFile(i.FilePath()) {
+		if !isSpecialAutogeneratedFile(i.FilePath(), p.goFileExtensions) {
 			p.log.Warnf("Got abs path %s in skip dirs processor, it should be relative", i.FilePath())
 		}
 		return true
@@ -0,0 +1,112 @@
+package processors
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+var fileSizeLimitRe = regexp.MustCompile(`(?i)^\s*(\d+)\s*(b|kb|mb|lines?)?\s*$`)
+
+// SkipLargeFiles drops issues of files larger than a limit, in bytes or in lines.
+type SkipLargeFiles struct {
+	limit        int
+	limitInLines bool
+
+	lineCache *fsutils.LineCache
+	log       logutils.Log
+
+	isLargeCache map[string]bool
+}
+
+var _ Processor = (*SkipLargeFiles)(nil)
+
+// NewSkipLargeFiles returns a processor for a limit like `500000`, `500KB`, `1MB` or `2000 lines`.
+// An empty or zero limit disables the processor.
+func NewSkipLargeFiles(limit string, lineCache *fsutils.LineCache, log logutils.Log) (*SkipLargeFiles, error) {
+	p := &SkipLargeFiles{
+		lineCache:    lineCache,
+		log:          log,
+		isLargeCache: map[string]bool{},
+	}
+
+	if limit == "" {
+		return p, nil
+	}
+
+	m := fileSizeLimitRe.FindStringSubmatch(limit)
+	if m == nil {
+		return nil, fmt.Errorf("invalid file size limit %q: expected a count of bytes (B, KB, MB) or lines", limit)
+	}
+
+	count, err := strconv.Atoi(m[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid file size limit %q: %w", limit, err)
+	}
+
+	switch strings.ToLower(m[2]) {
+	case "", "b":
+		p.limit = count
+	case "kb":
+		p.limit = count * 1024
+	case "mb":
+		p.limit = count * 1024 * 1024
+	default: // lines
+		p.limit = count
+		p.limitInLines = true
+	}
+
+	return p, nil
+}
+
+func (p SkipLargeFiles) Name() string {
+	return "skip_large_files"
+}
+
+func (p *SkipLargeFiles) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.limit <= 0 { // disabled
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		return !p.isLarge(i.FilePath())
+	}), nil
+}
+
+func (p SkipLargeFiles) Finish() {}
+
+func (p *SkipLargeFiles) isLarge(filePath string) bool {
+	if isLarge, ok := p.isLargeCache[filePath]; ok {
+		return isLarge
+	}
+
+	size, err := p.getSize(filePath)
+	if err != nil {
+		// don't drop issues of files we can't measure
+		p.log.Infof("Can't get the size of %s: %s", filePath, err)
+	}
+
+	isLarge := err == nil && size > p.limit
+	p.isLargeCache[filePath] = isLarge
+
+	return isLarge
+}
+
+func (p *SkipLargeFiles) getSize(filePath string) (int, error) {
+	if p.limitInLines {
+		return p.lineCache.GetLinesCount(filePath)
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(info.Size()), nil
+}
@@ -0,0 +1,60 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newTestSkipLargeFiles(t *testing.T, limit string) *SkipLargeFiles {
+	t.Helper()
+
+	p, err := NewSkipLargeFiles(limit, fsutils.NewLineCache(fsutils.NewFileCache()), logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestSkipLargeFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	small := filepath.Join(dir, "small.go")
+	require.NoError(t, os.WriteFile(small, []byte(strings.Repeat("a\n", 10)), 0o600))
+
+	large := filepath.Join(dir, "large.go")
+	require.NoError(t, os.WriteFile(large, []byte(strings.Repeat("a\n", 2000)), 0o600))
+
+	newIssue := func(path string) result.Issue {
+		return result.Issue{Pos: token.Position{Filename: path, Line: 1}}
+	}
+
+	for _, limit := range []string{"1000", "1KB", "100 lines"} {
+		t.Run(limit, func(t *testing.T) {
+			p := newTestSkipLargeFiles(t, limit)
+
+			processAssertSame(t, p, newIssue(small))
+			processAssertEmpty(t, p, newIssue(large))
+			processAssertSame(t, p, newIssue(filepath.Join(dir, "missing.go"))) // can't be measured
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		p := newTestSkipLargeFiles(t, "")
+
+		processAssertSame(t, p, newIssue(large))
+	})
+}
+
+func TestSkipLargeFilesInvalidLimit(t *testing.T) {
+	_, err := NewSkipLargeFiles("10 pages", nil, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	assert.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package processors
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const vendorDirName = "vendor"
+
+// SkipVendor drops the issues of the vendored code, whatever the path reported by the linters:
+// a file is vendored if one of its parent directories is a `vendor` directory at a module root
+// (a directory of a loaded module or containing a go.mod file), e.g. `vendor/` or `sub/module/vendor/`.
+// The nested vendor directories are inside such a directory.
+// The packages literally named vendor (e.g. `internal/vendor`) aren't vendored code.
+type SkipVendor struct {
+	enabled bool
+	log     logutils.Log
+
+	moduleDirs    map[string]bool // absolute directories of the loaded modules
+	vendorPkgDirs map[string]bool // absolute directories of the loaded packages named vendor
+
+	vendorDirsCache map[string]bool // absolute vendor directory -> is it a vendor directory at a module root
+	skippedCount    int
+}
+
+var _ Processor = (*SkipVendor)(nil)
+
+func NewSkipVendor(enabled bool, pkgs []*packages.Package, log logutils.Log) *SkipVendor {
+	p := &SkipVendor{
+		enabled:         enabled,
+		log:             log,
+		moduleDirs:      map[string]bool{},
+		vendorPkgDirs:   map[string]bool{},
+		vendorDirsCache: map[string]bool{},
+	}
+
+	for _, pkg := range pkgs {
+		if pkg.Module != nil && pkg.Module.Dir != "" {
+			p.moduleDirs[filepath.Clean(pkg.Module.Dir)] = true
+		}
+
+		// with -mod=vendor the vendored packages are loaded with their import path,
+		// so a package whose import path ends with vendor is really named vendor.
+		if path.Base(pkg.PkgPath) == vendorDirName {
+			for _, file := range pkg.GoFiles {
+				p.vendorPkgDirs[filepath.Dir(file)] = true
+			}
+		}
+	}
+
+	return p
+}
+
+func (p *SkipVendor) Name() string {
+	return "skip_vendor"
+}
+
+func (p *SkipVendor) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		if p.isVendored(i.FilePath()) {
+			p.skippedCount++
+			return false
+		}
+
+		return true
+	}), nil
+}
+
+func (p *SkipVendor) isVendored(filePath string) bool {
+	if !strings.Contains(filepath.ToSlash(filePath), vendorDirName+"/") {
+		return false // fast path
+	}
+
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		p.log.Warnf("Can't abs-ify path %q: %s", filePath, err)
+		return false
+	}
+
+	for dir := filepath.Dir(absPath); dir != filepath.Dir(dir); dir = filepath.Dir(dir) {
+		if filepath.Base(dir) == vendorDirName && p.isVendorDir(dir) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *SkipVendor) isVendorDir(dir string) bool {
+	if isVendor, ok := p.vendorDirsCache[dir]; ok {
+		return isVendor
+	}
+
+	isVendor := !p.vendorPkgDirs[dir] && p.isModuleRoot(filepath.Dir(dir))
+	p.vendorDirsCache[dir] = isVendor
+	return isVendor
+}
+
+func (p *SkipVendor) isModuleRoot(dir string) bool {
+	if p.moduleDirs[dir] {
+		return true
+	}
+
+	// e.g. a nested module not loaded.
+	_, err := os.Stat(filepath.Join(dir, "go.mod"))
+	return err == nil
+}
+
+func (p *SkipVendor) Finish() {
+	if p.skippedCount != 0 {
+		p.log.Infof("Skipped %d issues from vendored code", p.skippedCount)
+	}
+}
@@ -0,0 +1,87 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newSkipVendorTestIssue(path string) result.Issue {
+	return result.Issue{
+		FromLinter: "linter",
+		Text:       "text",
+		Pos:        token.Position{Filename: path, Line: 1},
+	}
+}
+
+func TestSkipVendor(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":                         "module example.com/m\n",
+		"vendor/modules.txt":             "",
+		"sub/go.mod":                     "module example.com/m/sub\n",
+		"other/vendor/foo/a.go":          "package foo\n",
+		"internal/vendor/a.go":           "package vendor\n",
+		"vendor/github.com/x/y/a.go":     "package y\n",
+		"sub/vendor/github.com/x/y/a.go": "package y\n",
+	}
+	for path, content := range files {
+		path = filepath.Join(root, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	file := func(path string) string {
+		return filepath.Join(root, filepath.FromSlash(path))
+	}
+
+	pkgs := []*packages.Package{
+		{
+			PkgPath: "example.com/m/internal/vendor",
+			GoFiles: []string{file("internal/vendor/a.go")},
+			Module:  &packages.Module{Path: "example.com/m", Dir: root},
+		},
+		{
+			PkgPath: "example.com/lib/vendor",
+			GoFiles: []string{file("lib/vendor/a.go")},
+			Module:  &packages.Module{Path: "example.com/lib", Dir: file("lib")},
+		},
+	}
+
+	p := NewSkipVendor(true, pkgs, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertEmpty(t, p,
+		newSkipVendorTestIssue(file("vendor/github.com/x/y/a.go")),
+		// nested vendor directories
+		newSkipVendorTestIssue(file("vendor/github.com/x/y/vendor/github.com/z/a.go")),
+		// vendor directory of a nested module
+		newSkipVendorTestIssue(file("sub/vendor/github.com/x/y/a.go")),
+	)
+
+	processAssertSame(t, p,
+		newSkipVendorTestIssue(file("a.go")),
+		// packages named vendor
+		newSkipVendorTestIssue(file("internal/vendor/a.go")),
+		newSkipVendorTestIssue(file("lib/vendor/a.go")),
+		// not at a module root
+		newSkipVendorTestIssue(file("other/vendor/foo/a.go")),
+		newSkipVendorTestIssue(file("vendored/a.go")),
+	)
+}
+
+func TestSkipVendorDisabled(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/m\n"), 0o600))
+
+	p := NewSkipVendor(false, nil, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p, newSkipVendorTestIssue(filepath.Join(root, "vendor", "foo", "a.go")))
+}
@@ -1,6 +1,8 @@
 package processors
 
 import (
+	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -18,34 +20,63 @@ var _ Processor = (*SortResults)(nil)
 type SortResults struct {
 	cmp comparator
 	cfg *config.Config
+
+	lowPriority []*regexp.Regexp
 }
 
 func NewSortResults(cfg *config.Config) *SortResults {
 	// For sorting we are comparing (in next order): file names, line numbers,
-	// position, and finally - giving up.
+	// position, linter names, texts, and finally - giving up.
 	return &SortResults{
 		cmp: ByName{
 			next: ByLine{
-				next: ByColumn{},
+				next: ByColumn{
+					next: ByLinter{
+						next: ByText{},
+					},
+				},
 			},
 		},
-		cfg: cfg,
+		cfg:         cfg,
+		lowPriority: compileGlobs(cfg.Output.LowPriorityPaths),
 	}
 }
 
 // Process is performing sorting of the result issues.
 func (sr SortResults) Process(issues []result.Issue) ([]result.Issue, error) {
-	if !sr.cfg.Output.SortResults {
+	var cmp comparator
+	if sr.cfg.Output.SortResults {
+		cmp = sr.cmp
+	}
+
+	// the issues of the low priority paths are last, even without sorting.
+	if len(sr.lowPriority) != 0 {
+		cmp = ByPriority{isLow: sr.isLowPriority, next: cmp}
+	}
+
+	if cmp == nil {
 		return issues, nil
 	}
 
-	sort.Slice(issues, func(i, j int) bool {
-		return sr.cmp.Compare(&issues[i], &issues[j]) == Less
+	// stable: the issues equal for all the comparators keep their order, the indices stay reproducible.
+	sort.SliceStable(issues, func(i, j int) bool {
+		return cmp.Compare(&issues[i], &issues[j]) == Less
 	})
 
 	return issues, nil
 }
 
+func (sr SortResults) isLowPriority(i *result.Issue) bool {
+	path := filepath.ToSlash(i.FilePath())
+	for _, re := range sr.lowPriority {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (sr SortResults) Name() string { return "sort_results" }
 func (sr SortResults) Finish()      {}
 
@@ -83,11 +114,36 @@ type comparator interface {
 }
 
 var (
+	_ comparator = (*ByPriority)(nil)
 	_ comparator = (*ByName)(nil)
 	_ comparator = (*ByLine)(nil)
 	_ comparator = (*ByColumn)(nil)
+	_ comparator = (*ByLinter)(nil)
+	_ comparator = (*ByText)(nil)
 )
 
+// ByPriority puts the low priority issues after the other ones.
+type ByPriority struct {
+	isLow func(i *result.Issue) bool
+	next  comparator
+}
+
+func (cmp ByPriority) Next() comparator { return cmp.next }
+
+func (cmp ByPriority) Compare(a, b *result.Issue) compareResult {
+	var res compareResult
+
+	if res = boolCompare(cmp.isLow(a), cmp.isLow(b)); !res.isNeutral() {
+		return res
+	}
+
+	if next := cmp.Next(); next != nil {
+		return next.Compare(a, b)
+	}
+
+	return res
+}
+
 type ByName struct{ next comparator }
 
 func (cmp ByName) Next() comparator { return cmp.next }
@@ -142,6 +198,42 @@ func (cmp ByColumn) Compare(a, b *result.Issue) compareResult {
 	return res
 }
 
+type ByLinter struct{ next comparator }
+
+func (cmp ByLinter) Next() comparator { return cmp.next }
+
+func (cmp ByLinter) Compare(a, b *result.Issue) compareResult {
+	var res compareResult
+
+	if res = compareResult(strings.Compare(a.FromLinter, b.FromLinter)); !res.isNeutral() {
+		return res
+	}
+
+	if next := cmp.Next(); next != nil {
+		return next.Compare(a, b)
+	}
+
+	return res
+}
+
+type ByText struct{ next comparator }
+
+func (cmp ByText) Next() comparator { return cmp.next }
+
+func (cmp ByText) Compare(a, b *result.Issue) compareResult {
+	var res compareResult
+
+	if res = compareResult(strings.Compare(a.Text, b.Text)); !res.isNeutral() {
+		return res
+	}
+
+	if next := cmp.Next(); next != nil {
+		return next.Compare(a, b)
+	}
+
+	return res
+}
+
 func numericCompare(a, b int) compareResult {
 	var (
 		isValuesInvalid  = a < 0 || b < 0
@@ -164,3 +256,58 @@ func numericCompare(a, b int) compareResult {
 
 	return Equal
 }
+
+// boolCompare orders false before true.
+func boolCompare(a, b bool) compareResult {
+	switch {
+	case a == b:
+		return Equal
+	case b:
+		return Less
+	default:
+		return Greater
+	}
+}
+
+// compileGlobs compiles the slash-separated globs: `*` and `?` don't match `/`,
+// `**` matches any number of path elements.
+func compileGlobs(globs []string) []*regexp.Regexp {
+	var res []*regexp.Regexp
+	for _, glob := range globs {
+		res = append(res, regexp.MustCompile(globToRegexp(glob)))
+	}
+
+	return res
+}
+
+func globToRegexp(glob string) string {
+	var b strings.Builder
+	b.WriteString("^")
+
+	for i := 0; i < len(glob); i++ {
+		switch glob[i] {
+		case '*':
+			if !strings.HasPrefix(glob[i:], "**") {
+				b.WriteString("[^/]*")
+				continue
+			}
+
+			i++
+			if strings.HasPrefix(glob[i+1:], "/") {
+				// `**/`: zero or more directories
+				i++
+				b.WriteString("(?:.*/)?")
+			} else {
+				b.WriteString(".*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+
+	b.WriteString("$")
+
+	return b.String()
+}
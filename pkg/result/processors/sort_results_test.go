@@ -3,6 +3,7 @@ package processors
 import (
 	"fmt"
 	"go/token"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -180,3 +181,72 @@ func TestSorting(t *testing.T) {
 	assert.Equal(t, results, expected)
 	assert.Nil(t, err, nil)
 }
+
+func TestCompareByLinterAndText(t *testing.T) {
+	testCompareValues(t, ByLinter{next: ByText{}}, "Compare By Linter and Text", []compareTestCase{
+		{result.Issue{FromLinter: "errcheck", Text: "b"}, result.Issue{FromLinter: "govet", Text: "a"}, Less},
+		{result.Issue{FromLinter: "govet", Text: "a"}, result.Issue{FromLinter: "errcheck", Text: "b"}, Greater},
+		{result.Issue{FromLinter: "govet", Text: "a"}, result.Issue{FromLinter: "govet", Text: "b"}, Less},
+		{result.Issue{FromLinter: "govet", Text: "a"}, result.Issue{FromLinter: "govet", Text: "a"}, Equal},
+	})
+}
+
+func TestSortingSamePosition(t *testing.T) {
+	pos := token.Position{Filename: "file.go", Line: 10, Column: 2}
+	govetB := result.Issue{FromLinter: "govet", Text: "b", Pos: pos}
+	govetA := result.Issue{FromLinter: "govet", Text: "a", Pos: pos}
+	errcheck := result.Issue{FromLinter: "errcheck", Text: "c", Pos: pos}
+
+	var cfg = config.Config{}
+	cfg.Output.SortResults = true
+	var sr = NewSortResults(&cfg)
+
+	results, err := sr.Process([]result.Issue{govetB, govetA, errcheck})
+	assert.Nil(t, err, nil)
+	assert.Equal(t, []result.Issue{errcheck, govetA, govetB}, results)
+}
+
+func TestGlobToRegexp(t *testing.T) {
+	testCases := []struct {
+		glob    string
+		path    string
+		matches bool
+	}{
+		{glob: "legacy/**", path: "legacy/a.go", matches: true},
+		{glob: "legacy/**", path: "legacy/sub/a.go", matches: true},
+		{glob: "legacy/**", path: "pkg/legacy/a.go", matches: false},
+		{glob: "**/*_gen.go", path: "a_gen.go", matches: true},
+		{glob: "**/*_gen.go", path: "pkg/sub/a_gen.go", matches: true},
+		{glob: "pkg/*.go", path: "pkg/sub/a.go", matches: false},
+		{glob: "pkg/?.go", path: "pkg/a.go", matches: true},
+		{glob: "pkg/a.go", path: "pkg/aago", matches: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.glob+" "+tc.path, func(t *testing.T) {
+			re := regexp.MustCompile(globToRegexp(tc.glob))
+			assert.Equal(t, tc.matches, re.MatchString(tc.path))
+		})
+	}
+}
+
+func TestSortingLowPriorityPaths(t *testing.T) {
+	legacy := result.Issue{FromLinter: "govet", Pos: token.Position{Filename: "legacy/a.go", Line: 1}}
+	other := result.Issue{FromLinter: "govet", Pos: token.Position{Filename: "pkg/b.go", Line: 2}}
+	first := result.Issue{FromLinter: "govet", Pos: token.Position{Filename: "a.go", Line: 3}}
+
+	var cfg = config.Config{}
+	cfg.Output.LowPriorityPaths = []string{"legacy/**"}
+
+	// without sorting, only the low priority issues move.
+	results, err := NewSortResults(&cfg).Process([]result.Issue{legacy, other, first})
+	assert.NoError(t, err)
+	assert.Equal(t, []result.Issue{other, first, legacy}, results)
+
+	cfg.Output.SortResults = true
+
+	results, err = NewSortResults(&cfg).Process([]result.Issue{legacy, other, first})
+	assert.NoError(t, err)
+	assert.Equal(t, []result.Issue{first, other, legacy}, results)
+}
@@ -1,6 +1,8 @@
 package processors
 
 import (
+	"sync"
+
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
@@ -9,14 +11,19 @@ import (
 type SourceCode struct {
 	lineCache *fsutils.LineCache
 	log       logutils.Log
+
+	// concurrency is the number of files read at once, whose lines are released once attached.
+	// 0: unbounded, the lines stay cached.
+	concurrency int
 }
 
 var _ Processor = SourceCode{}
 
-func NewSourceCode(lc *fsutils.LineCache, log logutils.Log) *SourceCode {
+func NewSourceCode(lc *fsutils.LineCache, log logutils.Log, concurrency int) *SourceCode {
 	return &SourceCode{
-		lineCache: lc,
-		log:       log,
+		lineCache:   lc,
+		log:         log,
+		concurrency: concurrency,
 	}
 }
 
@@ -25,23 +32,72 @@ func (p SourceCode) Name() string {
 }
 
 func (p SourceCode) Process(issues []result.Issue) ([]result.Issue, error) {
-	return transformIssues(issues, func(i *result.Issue) *result.Issue {
-		newI := *i
-
-		lineRange := i.GetLineRange()
-		for lineNumber := lineRange.From; lineNumber <= lineRange.To; lineNumber++ {
-			line, err := p.lineCache.GetLine(i.FilePath(), lineNumber)
-			if err != nil {
-				p.log.Warnf("Failed to get line %d for file %s: %s",
-					lineNumber, i.FilePath(), err)
-				return i
-			}
-
-			newI.SourceLines = append(newI.SourceLines, line)
+	if p.concurrency <= 0 {
+		return transformIssues(issues, p.attachSourceLines), nil
+	}
+
+	return p.processInBatches(issues), nil
+}
+
+// processInBatches attaches the source lines file by file, by batches of files read concurrently:
+// the lines of a batch are released before reading the next one, to bound the memory usage.
+func (p SourceCode) processInBatches(issues []result.Issue) []result.Issue {
+	var files []string
+	fileIssues := map[string][]int{} // file -> indexes of its issues
+	for ind := range issues {
+		file := issues[ind].FilePath()
+		if _, ok := fileIssues[file]; !ok {
+			files = append(files, file)
 		}
+		fileIssues[file] = append(fileIssues[file], ind)
+	}
+
+	retIssues := make([]result.Issue, len(issues))
+
+	for start := 0; start < len(files); start += p.concurrency {
+		end := start + p.concurrency
+		if end > len(files) {
+			end = len(files)
+		}
+
+		var wg sync.WaitGroup
+		for _, file := range files[start:end] {
+			wg.Add(1)
+			go func(indexes []int) {
+				defer wg.Done()
+
+				// each index is written by only one goroutine.
+				for _, ind := range indexes {
+					retIssues[ind] = *p.attachSourceLines(&issues[ind])
+				}
+			}(fileIssues[file])
+		}
+		wg.Wait()
+
+		for _, file := range files[start:end] {
+			p.lineCache.Forget(file)
+		}
+	}
+
+	return retIssues
+}
+
+func (p SourceCode) attachSourceLines(i *result.Issue) *result.Issue {
+	newI := *i
+
+	lineRange := i.GetLineRange()
+	for lineNumber := lineRange.From; lineNumber <= lineRange.To; lineNumber++ {
+		line, err := p.lineCache.GetLine(i.FilePath(), lineNumber)
+		if err != nil {
+			p.log.Warnf("Failed to get line %d for file %s: %s",
+				lineNumber, i.FilePath(), err)
+			return i
+		}
+
+		newI.SourceLines = append(newI.SourceLines, line)
+	}
 
-		return &newI
-	}), nil
+	return &newI
 }
 
 func (p SourceCode) Finish() {}
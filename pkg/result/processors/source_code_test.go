@@ -0,0 +1,61 @@
+package processors
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestSourceCode(t *testing.T) {
+	dir := t.TempDir()
+
+	var issues []result.Issue
+	for f := 0; f < 5; f++ {
+		path := filepath.Join(dir, fmt.Sprintf("f%d.go", f))
+		require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("package p\n\n// file %d\nvar x = 1\n", f)), 0o600))
+
+		issues = append(issues,
+			result.Issue{Text: "a", Pos: token.Position{Filename: path, Line: 3}},
+			result.Issue{Text: "b", Pos: token.Position{Filename: path, Line: 3}, LineRange: &result.Range{From: 3, To: 4}},
+		)
+	}
+
+	// the issues of a file aren't contiguous.
+	issues = append(issues, result.Issue{Text: "c", Pos: token.Position{Filename: issues[0].FilePath(), Line: 1}})
+
+	for _, concurrency := range []int{0, 1, 2, 10} {
+		concurrency := concurrency
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+			p := NewSourceCode(lineCache, logutils.NewStderrLog(logutils.DebugKeyEmpty), concurrency)
+
+			processedIssues, err := p.Process(issues)
+			require.NoError(t, err)
+			require.Len(t, processedIssues, len(issues))
+
+			for ind, i := range processedIssues {
+				assert.Equal(t, issues[ind].Text, i.Text)
+				assert.Equal(t, issues[ind].Pos, i.Pos)
+			}
+
+			f := func(n int) string { return fmt.Sprintf("// file %d", n) }
+			assert.Equal(t, []string{f(0)}, processedIssues[0].SourceLines)
+			assert.Equal(t, []string{f(0), "var x = 1"}, processedIssues[1].SourceLines)
+			assert.Equal(t, []string{f(4)}, processedIssues[8].SourceLines)
+			assert.Equal(t, []string{f(4), "var x = 1"}, processedIssues[9].SourceLines)
+			assert.Equal(t, []string{"package p"}, processedIssues[10].SourceLines)
+
+			// the input issues aren't modified.
+			assert.Nil(t, issues[0].SourceLines)
+		})
+	}
+}
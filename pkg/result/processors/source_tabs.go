@@ -0,0 +1,96 @@
+package processors
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// SourceTabs expands the tabs of the source lines attached by SourceCode to spaces, with tab stops every width characters,
+// and adjusts the column of the issue to the expanded first line: the column is then in characters,
+// the original column is kept in Issue.OriginalColumn.
+type SourceTabs struct {
+	width int
+}
+
+var _ Processor = SourceTabs{}
+
+func NewSourceTabs(width int) *SourceTabs {
+	return &SourceTabs{width: width}
+}
+
+func (p SourceTabs) Name() string {
+	return "source_tabs"
+}
+
+func (p SourceTabs) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.width <= 0 { // disabled
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		if len(i.SourceLines) == 0 {
+			return i
+		}
+
+		newI := *i
+
+		if i.Pos.Column > 0 {
+			newI.OriginalColumn = i.Pos.Column
+			newI.Pos.Column = p.expandedColumn(i.SourceLines[0], i.Pos.Column)
+		}
+
+		newI.SourceLines = make([]string, 0, len(i.SourceLines))
+		for _, line := range i.SourceLines {
+			newI.SourceLines = append(newI.SourceLines, p.expand(line))
+		}
+
+		return &newI
+	}), nil
+}
+
+func (p SourceTabs) Finish() {}
+
+func (p SourceTabs) expand(line string) string {
+	if !strings.Contains(line, "\t") {
+		return line
+	}
+
+	var b strings.Builder
+	col := 0
+	for _, r := range line {
+		if r != '\t' {
+			b.WriteRune(r)
+			col++
+			continue
+		}
+
+		spaces := p.width - col%p.width
+		b.WriteString(strings.Repeat(" ", spaces))
+		col += spaces
+	}
+
+	return b.String()
+}
+
+// expandedColumn returns the 1-based column in the expanded line of the 1-based byte column in the line.
+func (p SourceTabs) expandedColumn(line string, column int) int {
+	col := 0
+	for offset := 0; offset < column-1 && offset < len(line); {
+		r, size := utf8.DecodeRuneInString(line[offset:])
+		if r == '\t' {
+			col += p.width - col%p.width
+		} else {
+			col++
+		}
+		offset += size
+	}
+
+	// past the end of the line (e.g. a missing newline): keep the distance to the end.
+	if column-1 > len(line) {
+		col += column - 1 - len(line)
+	}
+
+	return col + 1
+}
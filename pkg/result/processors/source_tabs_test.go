@@ -0,0 +1,56 @@
+package processors
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestSourceTabs(t *testing.T) {
+	p := NewSourceTabs(4)
+
+	issue := result.Issue{
+		Pos:         token.Position{Filename: "a.go", Line: 2, Column: 3}, // `x` after 2 tabs
+		SourceLines: []string{"\t\tx := 1", "\ty\t= 2"},
+	}
+
+	out := process(t, p, issue)
+	if assert.Len(t, out, 1) {
+		assert.Equal(t, []string{"        x := 1", "    y   = 2"}, out[0].SourceLines)
+		assert.Equal(t, 9, out[0].Pos.Column)
+		assert.Equal(t, 3, out[0].OriginalColumn)
+	}
+
+	// the input issue isn't modified.
+	assert.Equal(t, []string{"\t\tx := 1", "\ty\t= 2"}, issue.SourceLines)
+}
+
+func TestSourceTabsExpandedColumn(t *testing.T) {
+	p := NewSourceTabs(8)
+
+	testCases := []struct {
+		line     string
+		column   int
+		expected int
+	}{
+		{line: "x := 1", column: 3, expected: 3},
+		{line: "\tx := 1", column: 2, expected: 9},
+		{line: "ab\tx", column: 4, expected: 9},
+		{line: "é\tx", column: 4, expected: 9}, // the columns are in bytes: `é` is 2 bytes
+		{line: "\tx", column: 4, expected: 11},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, p.expandedColumn(tc.line, tc.column), "%q:%d", tc.line, tc.column)
+	}
+}
+
+func TestSourceTabsDisabled(t *testing.T) {
+	processAssertSame(t, NewSourceTabs(0), result.Issue{
+		Pos:         token.Position{Filename: "a.go", Line: 1, Column: 2},
+		SourceLines: []string{"\tx := 1"},
+	})
+}
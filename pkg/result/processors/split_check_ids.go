@@ -0,0 +1,92 @@
+package processors
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// defaultCheckIDFormats are the formats of the check IDs prefixing the messages of the known linters:
+// the first capture group is the check ID, the rest of the message follows the match.
+var defaultCheckIDFormats = map[string]string{
+	"gocritic":    `^(\w+): `,
+	"gosec":       `^(G\d+): `,
+	"gosimple":    `^((?:S|QF)\d+): `,
+	"revive":      `^([\w-]+): `,
+	"staticcheck": `^(SA\d+): `,
+	"stylecheck":  `^(ST\d+): `,
+}
+
+// SplitCheckIDs moves the check ID prefixing the message of an issue, e.g. `SA1019: foo is deprecated`,
+// to the CheckID of the issue, the rest of the message being the text.
+// The messages without a check ID in the format of their linter are untouched,
+// as are the ones prefixed by another ID than the CheckID already set by the linter.
+type SplitCheckIDs struct {
+	enabled bool
+	formats map[string]*regexp.Regexp
+}
+
+var _ Processor = SplitCheckIDs{}
+
+// NewSplitCheckIDs returns a processor splitting the check IDs in the formats of the linters:
+// the formats override the default ones of the same linters.
+func NewSplitCheckIDs(enabled bool, formats map[string]string) (*SplitCheckIDs, error) {
+	p := &SplitCheckIDs{enabled: enabled, formats: map[string]*regexp.Regexp{}}
+
+	for linterName, format := range defaultCheckIDFormats {
+		p.formats[linterName] = regexp.MustCompile(format)
+	}
+
+	for linterName, format := range formats {
+		re, err := regexp.Compile(format)
+		if err != nil {
+			return nil, fmt.Errorf("invalid format %q of the linter %s: %w", format, linterName, err)
+		}
+
+		if re.NumSubexp() == 0 {
+			return nil, fmt.Errorf("the format %q of the linter %s has no capture group for the check ID", format, linterName)
+		}
+
+		p.formats[linterName] = re
+	}
+
+	return p, nil
+}
+
+func (p SplitCheckIDs) Name() string {
+	return "split_check_ids"
+}
+
+func (p SplitCheckIDs) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		re, ok := p.formats[i.FromLinter]
+		if !ok {
+			return i
+		}
+
+		// the check ID must prefix the message.
+		match := re.FindStringSubmatchIndex(i.Text)
+		if match == nil || match[0] != 0 || match[2] < 0 {
+			return i
+		}
+
+		checkID := i.Text[match[2]:match[3]]
+		text := strings.TrimLeft(i.Text[match[1]:], " ")
+		if checkID == "" || text == "" || (i.CheckID != "" && i.CheckID != checkID) {
+			return i
+		}
+
+		newI := *i
+		newI.CheckID = checkID
+		newI.Text = text
+		return &newI
+	}), nil
+}
+
+func (p SplitCheckIDs) Finish() {}
@@ -0,0 +1,91 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestSplitCheckIDs(t *testing.T) {
+	p, err := NewSplitCheckIDs(true, map[string]string{
+		"gocritic": `^check (\w+): `,
+		"custom":   `^\[(\w+)\]`,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc            string
+		issue           result.Issue
+		expectedCheckID string
+		expectedText    string
+	}{
+		{
+			desc:            "default format",
+			issue:           result.Issue{FromLinter: "staticcheck", Text: "SA1019: foo is deprecated", CheckID: "SA1019"},
+			expectedCheckID: "SA1019",
+			expectedText:    "foo is deprecated",
+		},
+		{
+			desc:            "without check ID set by the linter",
+			issue:           result.Issue{FromLinter: "gosec", Text: "G104: Errors unhandled."},
+			expectedCheckID: "G104",
+			expectedText:    "Errors unhandled.",
+		},
+		{
+			desc:            "configured format",
+			issue:           result.Issue{FromLinter: "custom", Text: "[C42] bad code"},
+			expectedCheckID: "C42",
+			expectedText:    "bad code",
+		},
+		{
+			desc:            "configured format overriding the default one",
+			issue:           result.Issue{FromLinter: "gocritic", Text: "check appendAssign: append result not assigned"},
+			expectedCheckID: "appendAssign",
+			expectedText:    "append result not assigned",
+		},
+		{
+			desc:         "unrecognized check ID",
+			issue:        result.Issue{FromLinter: "staticcheck", Text: "foo is deprecated (SA1019: bar)"},
+			expectedText: "foo is deprecated (SA1019: bar)",
+		},
+		{
+			desc:            "other check ID than the one set by the linter",
+			issue:           result.Issue{FromLinter: "revive", Text: "exported: comment", CheckID: "var-naming"},
+			expectedCheckID: "var-naming",
+			expectedText:    "exported: comment",
+		},
+		{
+			desc:         "linter without format",
+			issue:        result.Issue{FromLinter: "errcheck", Text: "E1: error return value is not checked"},
+			expectedText: "E1: error return value is not checked",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			processed := process(t, p, tc.issue)
+			require.Len(t, processed, 1)
+			assert.Equal(t, tc.expectedCheckID, processed[0].CheckID)
+			assert.Equal(t, tc.expectedText, processed[0].Text)
+		})
+	}
+}
+
+func TestSplitCheckIDsDisabled(t *testing.T) {
+	p, err := NewSplitCheckIDs(false, nil)
+	require.NoError(t, err)
+
+	processAssertSame(t, p, result.Issue{FromLinter: "gosec", Text: "G104: Errors unhandled."})
+}
+
+func TestSplitCheckIDsInvalidFormat(t *testing.T) {
+	_, err := NewSplitCheckIDs(true, map[string]string{"custom": `^\w+: `})
+	assert.EqualError(t, err, `the format "^\\w+: " of the linter custom has no capture group for the check ID`)
+
+	_, err = NewSplitCheckIDs(true, map[string]string{"custom": `^(\w+: `})
+	assert.Error(t, err)
+}
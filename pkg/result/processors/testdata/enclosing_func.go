@@ -0,0 +1,19 @@
+package testdata
+
+var enclosingFuncVar = 1
+
+func enclosingFunc() {
+	_ = enclosingFuncVar
+}
+
+type enclosingFuncType struct{}
+
+func (t *enclosingFuncType) pointerMethod() {
+	_ = t
+}
+
+func (t enclosingFuncType) valueMethod() {}
+
+type enclosingFuncGeneric[T any] struct{}
+
+func (g enclosingFuncGeneric[T]) genericMethod() {}
@@ -0,0 +1,10 @@
+package testdata
+
+//line template.tmpl:10
+func foo() {
+	var x int
+	_ = x
+}
+
+//line template.tmpl:20:3
+func bar() {}
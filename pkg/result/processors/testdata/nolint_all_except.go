@@ -0,0 +1,11 @@
+package testdata
+
+func retErr() error {
+	return nil
+}
+
+func _() {
+	retErr() //nolint:all except:errcheck,gas // gosec issues must be reported
+	retErr() //nolint:all except:unknown
+	retErr() //nolint:all
+}
@@ -0,0 +1,14 @@
+package testdata
+
+func retErr() error {
+	return nil
+}
+
+func _() {
+	retErr() //lint:ignore errcheck the error is always nil
+	retErr() //lint:ignore SA4006,gosec reason
+	retErr() //lint:ignore
+	retErr() //mylint:errcheck
+	retErr() //mylinter
+	retErr() //nolint:errcheck
+}
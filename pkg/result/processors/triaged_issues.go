@@ -0,0 +1,119 @@
+package processors
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// envTriagedFingerprintsToken is the bearer token sent when fetching the triaged fingerprints.
+const envTriagedFingerprintsToken = "GOLANGCI_LINT_TRIAGED_FINGERPRINTS_TOKEN"
+
+const triagedFingerprintsTimeout = 30 * time.Second
+
+// TriagedIssues drops the issues already triaged as "won't fix" on a review platform:
+// the fingerprints of these issues (see result.Issue.Fingerprint) are fetched once from an HTTP endpoint,
+// returning them as a JSON array of strings.
+// If the fetch fails, no issue is dropped (fail-open), unless failClosed is set: the error stops the run.
+type TriagedIssues struct {
+	log logutils.Log
+
+	fingerprints map[string]bool // nil if disabled
+	droppedCount int
+}
+
+var _ Processor = (*TriagedIssues)(nil)
+
+func NewTriagedIssues(url string, failClosed bool, log logutils.Log) (*TriagedIssues, error) {
+	p := &TriagedIssues{log: log}
+	if url == "" {
+		return p, nil
+	}
+
+	fingerprints, err := fetchTriagedFingerprints(url, os.Getenv(envTriagedFingerprintsToken), triagedFingerprintsTimeout)
+	if err != nil {
+		if failClosed {
+			return nil, fmt.Errorf("can't fetch the triaged fingerprints from %s: %w", url, err)
+		}
+
+		log.Warnf("Can't fetch the triaged fingerprints from %s, the triaged issues are reported: %s", url, err)
+		return p, nil
+	}
+
+	p.fingerprints = make(map[string]bool, len(fingerprints))
+	for _, fingerprint := range fingerprints {
+		p.fingerprints[strings.ToUpper(fingerprint)] = true
+	}
+
+	return p, nil
+}
+
+func (p *TriagedIssues) Name() string {
+	return "triaged_issues"
+}
+
+func (p *TriagedIssues) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.fingerprints) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		if p.fingerprints[i.Fingerprint()] {
+			p.droppedCount++
+			return false
+		}
+
+		return true
+	}), nil
+}
+
+func (p *TriagedIssues) Finish() {
+	if p.droppedCount != 0 {
+		p.log.Infof("Dropped %d issues triaged as won't fix", p.droppedCount)
+	}
+}
+
+// fetchTriagedFingerprints downloads the JSON array of the fingerprints,
+// with an `Authorization: Bearer` header if the token isn't empty.
+func fetchTriagedFingerprints(url, token string, timeout time.Duration) ([]string, error) {
+	req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := &http.Client{Timeout: timeout}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can't read response body: %w", err)
+	}
+
+	var fingerprints []string
+	if err := json.Unmarshal(data, &fingerprints); err != nil {
+		return nil, fmt.Errorf("can't decode the fingerprints, expected a JSON array of strings: %w", err)
+	}
+
+	return fingerprints, nil
+}
@@ -0,0 +1,66 @@
+package processors
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestTriagedIssues(t *testing.T) {
+	triaged := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "govet", Text: "won't fix"})
+	other := newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 2, Linter: "govet", Text: "to fix"})
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode([]string{strings.ToLower(triaged.Fingerprint())})
+	}))
+	defer ts.Close()
+
+	t.Setenv(envTriagedFingerprintsToken, "secret")
+
+	p, err := NewTriagedIssues(ts.URL, true, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	processAssertEmpty(t, p, triaged)
+	processAssertSame(t, p, other)
+}
+
+func TestTriagedIssuesFetchError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	log := logutils.NewMockLog()
+	log.On("Warnf", "Can't fetch the triaged fingerprints from %s, the triaged issues are reported: %s", ts.URL, mock.Anything)
+
+	// fail-open: no issue is dropped.
+	p, err := NewTriagedIssues(ts.URL, false, log)
+	require.NoError(t, err)
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "govet"}))
+	log.AssertExpectations(t)
+
+	// fail-closed: the run stops.
+	_, err = NewTriagedIssues(ts.URL, true, log)
+	assert.EqualError(t, err, "can't fetch the triaged fingerprints from "+ts.URL+
+		": unexpected response status 500 Internal Server Error")
+}
+
+func TestTriagedIssuesDisabled(t *testing.T) {
+	p, err := NewTriagedIssues("", true, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newIssueFromIssueTestCase(issueTestCase{Path: "a.go", Line: 1, Linter: "govet"}))
+}
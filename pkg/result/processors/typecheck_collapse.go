@@ -0,0 +1,141 @@
+package processors
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const typecheckLinterName = "typecheck"
+
+// typecheckRootCauseRe matches typecheck errors that usually are the root cause of cascading errors.
+var typecheckRootCauseRe = regexp.MustCompile(`(^|\W)(undefined:|undeclared name:|could not import|imported and not used)`)
+
+// TypecheckCollapse collapses cascading typecheck errors of a package:
+// only the earliest error and the likely root causes are kept.
+type TypecheckCollapse struct {
+	enabled bool
+	log     logutils.Log
+
+	suppressedCount int
+}
+
+var _ Processor = &TypecheckCollapse{}
+
+func NewTypecheckCollapse(enabled bool, log logutils.Log) *TypecheckCollapse {
+	return &TypecheckCollapse{
+		enabled: enabled,
+		log:     log,
+	}
+}
+
+func (p TypecheckCollapse) Name() string {
+	return "typecheck_collapse"
+}
+
+type typecheckGroup struct {
+	first      int // index of the issue with the earliest position
+	hasRoot    bool
+	suppressed int
+}
+
+func (p *TypecheckCollapse) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	groups := map[string]*typecheckGroup{}
+	for ind := range issues {
+		i := &issues[ind]
+		if i.FromLinter != typecheckLinterName {
+			continue
+		}
+
+		key := typecheckGroupKey(i)
+		g := groups[key]
+		if g == nil {
+			g = &typecheckGroup{first: ind}
+			groups[key] = g
+		} else if isPositionBefore(i, &issues[g.first]) {
+			g.first = ind
+		}
+
+		if typecheckRootCauseRe.MatchString(i.Text) {
+			g.hasRoot = true
+		}
+	}
+
+	for ind := range issues {
+		i := &issues[ind]
+		if i.FromLinter != typecheckLinterName {
+			continue
+		}
+
+		g := groups[typecheckGroupKey(i)]
+		if g.hasRoot && ind != g.first && !typecheckRootCauseRe.MatchString(i.Text) {
+			g.suppressed++
+		}
+	}
+
+	retIssues := make([]result.Issue, 0, len(issues))
+	for ind := range issues {
+		i := issues[ind]
+		if i.FromLinter != typecheckLinterName {
+			retIssues = append(retIssues, i)
+			continue
+		}
+
+		g := groups[typecheckGroupKey(&i)]
+		if !g.hasRoot {
+			// we can't guess the root cause: don't hide genuinely distinct errors
+			retIssues = append(retIssues, i)
+			continue
+		}
+
+		if ind == g.first {
+			if g.suppressed != 0 {
+				i.Text = fmt.Sprintf("%s (and %d follow-on errors)", i.Text, g.suppressed)
+			}
+			retIssues = append(retIssues, i)
+			continue
+		}
+
+		if typecheckRootCauseRe.MatchString(i.Text) {
+			retIssues = append(retIssues, i)
+			continue
+		}
+
+		p.suppressedCount++
+	}
+
+	return retIssues, nil
+}
+
+func (p TypecheckCollapse) Finish() {
+	if p.suppressedCount != 0 {
+		p.log.Infof("%d cascading typecheck issues were collapsed by issues.collapse-typecheck", p.suppressedCount)
+	}
+}
+
+func typecheckGroupKey(i *result.Issue) string {
+	if i.Pkg != nil {
+		return i.Pkg.ID
+	}
+
+	return filepath.Dir(i.FilePath())
+}
+
+func isPositionBefore(a, b *result.Issue) bool {
+	if a.FilePath() != b.FilePath() {
+		return a.FilePath() < b.FilePath()
+	}
+
+	if a.Line() != b.Line() {
+		return a.Line() < b.Line()
+	}
+
+	return a.Column() < b.Column()
+}
@@ -0,0 +1,45 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newTypecheckIssue(path string, line int, text string) result.Issue {
+	return newIssueFromIssueTestCase(issueTestCase{Path: path, Line: line, Text: text, Linter: typecheckLinterName})
+}
+
+func TestTypecheckCollapse(t *testing.T) {
+	p := NewTypecheckCollapse(true, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	issues := []result.Issue{
+		newTypecheckIssue("a/b.go", 10, "cannot use x (variable of type int) as string value"),
+		newTypecheckIssue("a/b.go", 3, `could not import github.com/foo/bar (no required module provides package)`),
+		newTypecheckIssue("a/b.go", 12, "undefined: bar"),
+		newTypecheckIssue("a/c.go", 5, "x.Foo undefined (type T has no field or method Foo)"),
+		newTypecheckIssue("other/d.go", 7, "cannot use y (variable of type int) as string value"),
+		newIssueFromIssueTestCase(issueTestCase{Path: "a/b.go", Line: 11, Text: "some issue", Linter: "govet"}),
+	}
+
+	expected := []result.Issue{
+		newTypecheckIssue("a/b.go", 3, `could not import github.com/foo/bar (no required module provides package) (and 2 follow-on errors)`),
+		newTypecheckIssue("a/b.go", 12, "undefined: bar"),
+		newTypecheckIssue("other/d.go", 7, "cannot use y (variable of type int) as string value"),
+		newIssueFromIssueTestCase(issueTestCase{Path: "a/b.go", Line: 11, Text: "some issue", Linter: "govet"}),
+	}
+
+	assert.Equal(t, expected, process(t, p, issues...))
+}
+
+func TestTypecheckCollapseDisabled(t *testing.T) {
+	p := NewTypecheckCollapse(false, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	processAssertSame(t, p,
+		newTypecheckIssue("a/b.go", 10, "cannot use x (variable of type int) as string value"),
+		newTypecheckIssue("a/b.go", 12, "undefined: bar"),
+	)
+}
@@ -31,11 +31,16 @@ func (p *UniqByLine) Process(issues []result.Issue) ([]result.Issue, error) {
 		return issues, nil
 	}
 
-	return filterIssues(issues, func(i *result.Issue) bool {
+	// survivors are the indexes in retIssues of the issues kept for a file and a line in this call.
+	survivors := map[string]map[int]int{}
+	retIssues := make([]result.Issue, 0, len(issues))
+	for ind := range issues {
+		i := &issues[ind]
 		if i.Replacement != nil && p.cfg.Issues.NeedFix {
 			// if issue will be auto-fixed we shouldn't collapse issues:
 			// e.g. one line can contain 2 misspellings, they will be in 2 issues and misspell should fix both of them.
-			return true
+			retIssues = append(retIssues, *i)
+			continue
 		}
 
 		lc := p.flc[i.FilePath()]
@@ -47,12 +52,34 @@ func (p *UniqByLine) Process(issues []result.Issue) ([]result.Issue, error) {
 		const limit = 1
 		count := lc[i.Line()]
 		if count == limit {
-			return false
+			if survivor, ok := survivors[i.FilePath()][i.Line()]; ok {
+				addDuplicate(&retIssues[survivor], i)
+			}
+			continue
 		}
 
 		lc[i.Line()]++
-		return true
-	}), nil
+		if survivors[i.FilePath()] == nil {
+			survivors[i.FilePath()] = map[int]int{}
+		}
+		survivors[i.FilePath()][i.Line()] = len(retIssues)
+		retIssues = append(retIssues, *i)
+	}
+
+	return retIssues, nil
 }
 
 func (p UniqByLine) Finish() {}
+
+// addDuplicate keeps the dropped duplicate, with its own duplicates, on the survivor:
+// the severity rules give the survivor the highest severity of the set.
+func addDuplicate(survivor, duplicate *result.Issue) {
+	// not the memory of the duplicates of the issues before the processing.
+	duplicates := make([]result.Issue, 0, len(survivor.Duplicates)+1+len(duplicate.Duplicates))
+	duplicates = append(duplicates, survivor.Duplicates...)
+	duplicates = append(duplicates, *duplicate)
+	duplicates[len(duplicates)-1].Duplicates = nil
+	duplicates = append(duplicates, duplicate.Duplicates...)
+
+	survivor.Duplicates = duplicates
+}
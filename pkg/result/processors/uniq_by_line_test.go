@@ -4,6 +4,8 @@ import (
 	"go/token"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -32,6 +34,25 @@ func TestUniqByLine(t *testing.T) {
 	processAssertSame(t, p, newFLIssue("f2", 1)) // another file
 }
 
+func TestUniqByLineDuplicates(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Output.UniqByLine = true
+
+	p := NewUniqByLine(&cfg)
+
+	i1 := newFLIssue("f1", 1)
+	i1.FromLinter = "revive"
+	i2 := newFLIssue("f1", 1)
+	i2.FromLinter = "gosec"
+	i3 := newFLIssue("f1", 2)
+
+	processedIssues := process(t, p, i1, i3, i2)
+	assert.Len(t, processedIssues, 2)
+	assert.Equal(t, "revive", processedIssues[0].FromLinter)
+	assert.Equal(t, []result.Issue{i2}, processedIssues[0].Duplicates)
+	assert.Empty(t, processedIssues[1].Duplicates)
+}
+
 func TestUniqByLineDisabled(t *testing.T) {
 	cfg := config.Config{}
 	cfg.Output.UniqByLine = false
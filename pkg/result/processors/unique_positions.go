@@ -0,0 +1,80 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// UniquePositions keeps at most one issue per position (file, line and column), whatever its linter and text:
+// the one with the highest severity, then the highest linter weight (see issues.linter-weights),
+// then the first linter by name. It must run after the severities are assigned.
+// The kept issue takes the place of the first issue of its position.
+type UniquePositions struct {
+	enabled bool
+	weights map[string]int
+}
+
+var _ Processor = UniquePositions{}
+
+func NewUniquePositions(enabled bool, weights map[string]int) *UniquePositions {
+	return &UniquePositions{
+		enabled: enabled,
+		weights: weights,
+	}
+}
+
+func (p UniquePositions) Name() string {
+	return "unique_positions"
+}
+
+func (p UniquePositions) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	type position struct {
+		file         string
+		line, column int
+	}
+
+	var retIssues []result.Issue
+	kept := map[position]int{} // position -> index in retIssues
+	for ind := range issues {
+		i := &issues[ind]
+		pos := position{file: i.FilePath(), line: i.Line(), column: i.Column()}
+
+		keptInd, ok := kept[pos]
+		if !ok {
+			kept[pos] = len(retIssues)
+			retIssues = append(retIssues, *i)
+			continue
+		}
+
+		if p.wins(i, &retIssues[keptInd]) {
+			retIssues[keptInd] = *i
+		}
+	}
+
+	return retIssues, nil
+}
+
+// wins checks that the issue i takes precedence over the kept issue of its position.
+func (p UniquePositions) wins(i, kept *result.Issue) bool {
+	if rank, keptRank := severityRank(i.Severity), severityRank(kept.Severity); rank != keptRank {
+		return rank > keptRank
+	}
+
+	if weight, keptWeight := p.weight(i), p.weight(kept); weight != keptWeight {
+		return weight > keptWeight
+	}
+
+	return i.FromLinter < kept.FromLinter
+}
+
+func (p UniquePositions) weight(i *result.Issue) int {
+	if w, ok := p.weights[i.FromLinter]; ok {
+		return w
+	}
+	return defaultLinterWeight
+}
+
+func (p UniquePositions) Finish() {}
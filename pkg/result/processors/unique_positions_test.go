@@ -0,0 +1,57 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newPositionIssue(linter string, line, column int, severity string) result.Issue {
+	i := newRangeIssue(linter, "a.go", linter+" text", line, column)
+	i.Severity = severity
+	return i
+}
+
+func TestUniquePositions(t *testing.T) {
+	p := NewUniquePositions(true, map[string]int{"gosec": 5})
+
+	processedIssues := process(t, p,
+		newPositionIssue("revive", 1, 1, "warning"),
+		newPositionIssue("errcheck", 1, 1, "error"), // the highest severity wins
+		newPositionIssue("govet", 1, 1, "info"),
+		newPositionIssue("revive", 1, 2, "warning"), // another column
+		newPositionIssue("revive", 2, 1, "warning"),
+		newPositionIssue("gosec", 2, 1, "warning"), // the same severity: the highest weight wins
+		newPositionIssue("lll", 3, 1, "warning"),
+		newPositionIssue("errcheck", 3, 1, "warning"), // the same severity and weight: the first linter by name wins
+		newPositionIssue("revive", 4, 0, "warning"),
+		newPositionIssue("unknown", 4, 0, "critical"), // unknown severities rank the lowest
+	)
+
+	type position struct {
+		linter       string
+		line, column int
+	}
+
+	var positions []position
+	for _, i := range processedIssues {
+		positions = append(positions, position{linter: i.FromLinter, line: i.Line(), column: i.Column()})
+	}
+
+	expected := []position{
+		{linter: "errcheck", line: 1, column: 1},
+		{linter: "revive", line: 1, column: 2},
+		{linter: "gosec", line: 2, column: 1},
+		{linter: "errcheck", line: 3, column: 1},
+		{linter: "revive", line: 4, column: 0},
+	}
+	assert.Equal(t, expected, positions)
+}
+
+func TestUniquePositionsDisabled(t *testing.T) {
+	p := NewUniquePositions(false, nil)
+
+	processAssertSame(t, p, newPositionIssue("revive", 1, 1, "warning"), newPositionIssue("errcheck", 1, 1, "error"))
+}
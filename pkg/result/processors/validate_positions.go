@@ -0,0 +1,128 @@
+package processors
+
+import (
+	"fmt"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+var validatePositionsDebugf = logutils.Debug(logutils.DebugKeyValidatePositions)
+
+const (
+	InvalidPositionsDrop  = "drop"
+	InvalidPositionsClamp = "clamp"
+)
+
+// ValidatePositions drops, or clamps to the end of the file and of the line, the issues
+// whose line or column is outside the file: some linters report lines beyond the end of the file.
+type ValidatePositions struct {
+	clamp     bool
+	lineCache *fsutils.LineCache
+}
+
+var _ Processor = (*ValidatePositions)(nil)
+
+// NewValidatePositions returns a processor for a mode `drop` (the default if empty) or `clamp`.
+func NewValidatePositions(mode string, lineCache *fsutils.LineCache) (*ValidatePositions, error) {
+	switch mode {
+	case "", InvalidPositionsDrop, InvalidPositionsClamp:
+	default:
+		return nil, fmt.Errorf("invalid positions mode %q: expected %q or %q", mode, InvalidPositionsDrop, InvalidPositionsClamp)
+	}
+
+	return &ValidatePositions{
+		clamp:     mode == InvalidPositionsClamp,
+		lineCache: lineCache,
+	}, nil
+}
+
+func (p ValidatePositions) Name() string {
+	return "validate_positions"
+}
+
+func (p *ValidatePositions) Process(issues []result.Issue) ([]result.Issue, error) {
+	var ret []result.Issue
+	for i := range issues {
+		issue, ok := p.validate(&issues[i])
+		if ok {
+			ret = append(ret, *issue)
+		}
+	}
+
+	return ret, nil
+}
+
+func (p ValidatePositions) Finish() {}
+
+// validate returns the issue, clamped if needed, and false if the issue must be dropped.
+func (p *ValidatePositions) validate(i *result.Issue) (*result.Issue, bool) {
+	if i.FilePath() == "" {
+		return i, true
+	}
+
+	linesCount, err := p.lineCache.GetLinesCount(i.FilePath())
+	if err != nil {
+		// the file can't be read: the position can't be validated
+		validatePositionsDebugf("Can't validate the position of the issue %s: %s", i.Pos, err)
+		return i, true
+	}
+
+	// a zero line or column means it's unknown
+	lineValid := i.Line() >= 0 && i.Line() <= linesCount
+	rangeValid := i.LineRange == nil || (i.LineRange.From >= 0 && i.LineRange.To <= linesCount)
+
+	colValid := i.Column() >= 0
+	var lineLen int
+	if lineValid && i.Line() > 0 && i.Column() > 0 {
+		line, err := p.lineCache.GetLine(i.FilePath(), i.Line())
+		if err != nil {
+			validatePositionsDebugf("Can't validate the position of the issue %s: %s", i.Pos, err)
+			return i, true
+		}
+
+		lineLen = len(line)
+		colValid = i.Column() <= lineLen+1 // the position can be after the last character
+	}
+
+	if lineValid && rangeValid && colValid {
+		return i, true
+	}
+
+	if !p.clamp {
+		validatePositionsDebugf("Dropped the issue from %s with the invalid position %s (%d lines): %s",
+			i.FromLinter, i.Pos, linesCount, i.Text)
+		return nil, false
+	}
+
+	newI := *i
+	switch {
+	case !lineValid:
+		newI.Pos.Line = clampInt(i.Line(), 0, linesCount)
+		newI.Pos.Column = 0 // the column of another line is meaningless
+	case !colValid:
+		newI.Pos.Column = clampInt(i.Column(), 0, lineLen+1)
+	}
+
+	if !rangeValid {
+		newI.LineRange = &result.Range{
+			From: clampInt(i.LineRange.From, 0, linesCount),
+			To:   clampInt(i.LineRange.To, 0, linesCount),
+		}
+	}
+
+	validatePositionsDebugf("Clamped the issue from %s with the invalid position %s (%d lines) to %s",
+		i.FromLinter, i.Pos, linesCount, newI.Pos)
+	return &newI, true
+}
+
+func clampInt(v, lowest, highest int) int {
+	if v < lowest {
+		return lowest
+	}
+	if v > highest {
+		return highest
+	}
+	return v
+}
@@ -0,0 +1,90 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newTestValidatePositions(t *testing.T, mode string) (*ValidatePositions, string) {
+	t.Helper()
+
+	p, err := NewValidatePositions(mode, fsutils.NewLineCache(fsutils.NewFileCache()))
+	require.NoError(t, err)
+
+	filePath := filepath.Join(t.TempDir(), "file.go")
+	require.NoError(t, os.WriteFile(filePath, []byte("package p\n\nvar v = 1\n"), 0o600))
+
+	return p, filePath
+}
+
+func newValidatePositionsIssue(filePath string, line, col int) result.Issue {
+	return result.Issue{
+		Text: "text",
+		Pos: token.Position{
+			Filename: filePath,
+			Line:     line,
+			Column:   col,
+		},
+	}
+}
+
+func TestValidatePositionsDrop(t *testing.T) {
+	p, filePath := newTestValidatePositions(t, "")
+
+	valid := []result.Issue{
+		newValidatePositionsIssue(filePath, 3, 5),
+		newValidatePositionsIssue(filePath, 3, 10), // after the last character
+		newValidatePositionsIssue(filePath, 1, 0),  // unknown column
+		newValidatePositionsIssue(filePath, 0, 0),  // unknown line
+		newValidatePositionsIssue("", 100, 0),      // unknown file
+		newValidatePositionsIssue(filepath.Join(filepath.Dir(filePath), "missing.go"), 100, 0),
+	}
+	processAssertSame(t, p, valid...)
+
+	processAssertEmpty(t, p,
+		newValidatePositionsIssue(filePath, 4, 0),
+		newValidatePositionsIssue(filePath, 3, 11),
+		newValidatePositionsIssue(filePath, -1, 0),
+	)
+
+	outOfRange := newValidatePositionsIssue(filePath, 2, 0)
+	outOfRange.LineRange = &result.Range{From: 2, To: 10}
+	processAssertEmpty(t, p, outOfRange)
+}
+
+func TestValidatePositionsClamp(t *testing.T) {
+	p, filePath := newTestValidatePositions(t, InvalidPositionsClamp)
+
+	beyondEOF := newValidatePositionsIssue(filePath, 10, 4)
+	beyondEOL := newValidatePositionsIssue(filePath, 3, 20)
+	outOfRange := newValidatePositionsIssue(filePath, 2, 0)
+	outOfRange.LineRange = &result.Range{From: 2, To: 10}
+
+	processed := process(t, p, beyondEOF, beyondEOL, outOfRange)
+	require.Len(t, processed, 3)
+
+	assert.Equal(t, 3, processed[0].Line())
+	assert.Equal(t, 0, processed[0].Column())
+
+	assert.Equal(t, 3, processed[1].Line())
+	assert.Equal(t, 10, processed[1].Column())
+
+	assert.Equal(t, &result.Range{From: 2, To: 3}, processed[2].LineRange)
+
+	// the input issues aren't modified
+	assert.Equal(t, 10, beyondEOF.Line())
+	assert.Equal(t, 10, outOfRange.LineRange.To)
+}
+
+func TestValidatePositionsInvalidMode(t *testing.T) {
+	_, err := NewValidatePositions("fix", fsutils.NewLineCache(fsutils.NewFileCache()))
+	require.Error(t, err)
+}
@@ -0,0 +1,93 @@
+package timeutils
+
+import (
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FileTimings accumulates the analysis time of the files.
+// The analyzers run on whole packages: the time of a package is split between its files by size,
+// so the time of a file is an estimate.
+type FileTimings struct {
+	durations map[string]time.Duration
+	sizes     map[string]int64
+	mu        sync.Mutex
+}
+
+// FileDuration is the accumulated analysis time of a file.
+type FileDuration struct {
+	File     string
+	Duration time.Duration
+}
+
+func NewFileTimings() *FileTimings {
+	return &FileTimings{
+		durations: map[string]time.Duration{},
+		sizes:     map[string]int64{},
+	}
+}
+
+// AddPackage splits the analysis time of a package between its files, proportionally to their sizes:
+// equally if the sizes are unknown.
+func (t *FileTimings) AddPackage(files []string, d time.Duration) {
+	if len(files) == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var total int64
+	sizes := make([]int64, len(files))
+	for i, file := range files {
+		sizes[i] = t.getSize(file)
+		total += sizes[i]
+	}
+
+	for i, file := range files {
+		if total == 0 {
+			t.durations[file] += d / time.Duration(len(files))
+			continue
+		}
+
+		t.durations[file] += time.Duration(float64(d) * float64(sizes[i]) / float64(total))
+	}
+}
+
+func (t *FileTimings) getSize(file string) int64 {
+	size, ok := t.sizes[file]
+	if !ok {
+		if fi, err := os.Stat(file); err == nil {
+			size = fi.Size()
+		}
+		t.sizes[file] = size
+	}
+
+	return size
+}
+
+// Top returns the n slowest files, from the slowest.
+func (t *FileTimings) Top(n int) []FileDuration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	fileDurations := make([]FileDuration, 0, len(t.durations))
+	for file, d := range t.durations {
+		fileDurations = append(fileDurations, FileDuration{File: file, Duration: d})
+	}
+
+	sort.Slice(fileDurations, func(i, j int) bool {
+		if fileDurations[i].Duration != fileDurations[j].Duration {
+			return fileDurations[i].Duration > fileDurations[j].Duration
+		}
+		return fileDurations[i].File < fileDurations[j].File
+	})
+
+	if n < len(fileDurations) {
+		fileDurations = fileDurations[:n]
+	}
+
+	return fileDurations
+}
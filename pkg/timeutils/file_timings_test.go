@@ -0,0 +1,36 @@
+package timeutils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileTimings(t *testing.T) {
+	dir := t.TempDir()
+
+	small, large := filepath.Join(dir, "small.go"), filepath.Join(dir, "large.go")
+	require.NoError(t, os.WriteFile(small, make([]byte, 100), 0o600))
+	require.NoError(t, os.WriteFile(large, make([]byte, 300), 0o600))
+
+	missing1, missing2 := filepath.Join(dir, "missing1.go"), filepath.Join(dir, "missing2.go")
+
+	timings := NewFileTimings()
+	timings.AddPackage([]string{small, large}, 4*time.Second) // split by size
+	timings.AddPackage([]string{large}, time.Second)          // accumulated
+	timings.AddPackage([]string{missing1, missing2}, 3*time.Second)
+	timings.AddPackage(nil, time.Second)
+
+	expected := []FileDuration{
+		{File: large, Duration: 4 * time.Second},
+		{File: missing1, Duration: 1500 * time.Millisecond}, // unknown sizes: split equally, sorted by name
+		{File: missing2, Duration: 1500 * time.Millisecond},
+		{File: small, Duration: time.Second},
+	}
+	assert.Equal(t, expected, timings.Top(10))
+	assert.Equal(t, expected[:2], timings.Top(2))
+}
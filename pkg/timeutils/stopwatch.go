@@ -106,6 +106,19 @@ func (s *Stopwatch) PrintTopStages(n int) {
 	s.log.Infof("%s took %s with %s", s.name, stagesDuration, s.sprintTopStages(n))
 }
 
+// StageDurations returns the durations of the stages, by stage name.
+func (s *Stopwatch) StageDurations() map[string]time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	durations := make(map[string]time.Duration, len(s.stages))
+	for name, d := range s.stages {
+		durations[name] = d
+	}
+
+	return durations
+}
+
 func (s *Stopwatch) TrackStage(name string, f func()) {
 	startedAt := time.Now()
 	f()
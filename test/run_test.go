@@ -284,6 +284,8 @@ func TestLineDirective(t *testing.T) {
 			t.Parallel()
 
 			testshared.NewRunnerBuilder(t).
+				// the package doesn't compile: the files are only parsed, as these linters need.
+				WithArgs("--load-mode=auto").
 				WithArgs(test.args...).
 				WithTargetPath(testdataDir, test.targetPath).
 				WithConfigFile(test.configPath).